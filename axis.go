@@ -32,10 +32,12 @@ func (e *Excel) SetAxisCoordinates(col int, row int) {
 
 func (r *Reader) setAxis(axis string) {
 	setAxis(&r.Axis, axis)
+	r.Range = nil
 }
 
 func (r *Reader) setAxisCoordinates(col int, row int) {
 	setAxisCoordinates(&r.Axis, col, row)
+	r.Range = nil
 }
 
 func (r *Reader) isAxisValid() bool {
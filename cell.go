@@ -0,0 +1,299 @@
+package excel
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-mods/convert"
+	"github.com/xuri/excelize/v2"
+)
+
+var (
+	cellType      = reflect.TypeOf(Cell{})
+	formulaType   = reflect.TypeOf(Formula(""))
+	hyperlinkType = reflect.TypeOf(Hyperlink{})
+)
+
+// Cell holds the value of a cell and its metadata.
+// A field of type Cell or *Cell is filled with the content of the cell when reading.
+//
+// Example:
+//
+//	type Product struct {
+//		Name  string     `excel:"Name"`
+//		Price excel.Cell `excel:"Price"`
+//	}
+type Cell struct {
+	// Value is the formatted value of the cell
+	Value string
+	// Formula is the formula of the cell, if any
+	Formula string
+	// StyleID is the style of the cell
+	StyleID int
+	// Axis is the name of the cell (ie: B2)
+	Axis string
+	// Col is the 1-based column number of the cell
+	Col int
+	// Row is the 1-based row number of the cell
+	Row int
+}
+
+// Marshall writes the value of the cell
+func (c *Cell) Marshall() (interface{}, error) {
+	return c.Value, nil
+}
+
+// Unmarshall reads the value of the cell
+func (c *Cell) Unmarshall(s string) error {
+	c.Value = s
+	return nil
+}
+
+// Formula is a cell formula.
+// A field of type Formula is written with SetCellFormula and
+// is filled with the formula of the cell when reading.
+// The leading '=' is optional.
+type Formula string
+
+// Hyperlink is a cell holding a link.
+// When writing, Text is displayed in the cell (URL if Text is empty).
+// A URL starting with '#' is a location inside the workbook (ie: #Sheet1!A1).
+// When reading, Text is the value of the cell and URL the target of the link.
+// Targets naming a cell of a sheet of the workbook or a defined name are read as locations.
+type Hyperlink struct {
+	URL     string
+	Text    string
+	Tooltip string
+}
+
+// isCell returns true if the field holds a Cell
+func (f *Field) isCell() bool {
+	return f.Type == cellType || (f.Type.Kind() == reflect.Pointer && f.Type.Elem() == cellType)
+}
+
+// isFormula returns true if the field holds a Formula
+func (f *Field) isFormula() bool {
+	return f.Type == formulaType || (f.Type.Kind() == reflect.Pointer && f.Type.Elem() == formulaType)
+}
+
+// isHyperlink returns true if the field holds a Hyperlink
+func (f *Field) isHyperlink() bool {
+	return f.Type == hyperlinkType || (f.Type.Kind() == reflect.Pointer && f.Type.Elem() == hyperlinkType)
+}
+
+// readSource returns where the value of the field is read from
+func (f *Field) readSource() string {
+	if source := f.GetReadSource(); len(source) > 0 {
+		return source
+	}
+	if f.isFormula() {
+		return SourceFormula
+	}
+	return SourceValue
+}
+
+// readCell returns the content of a cell and its metadata
+func (r *StructReader) readCell(col int, row int, value string) (reflect.Value, error) {
+	axis, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	cell := Cell{
+		Value: value,
+		Axis:  axis,
+		Col:   col,
+		Row:   row,
+	}
+	sheet := r.Reader.Sheet.Name
+	if cell.Formula, err = r.Reader.file.GetCellFormula(sheet, axis); err != nil {
+		return reflect.Value{}, err
+	}
+	if cell.StyleID, err = r.Reader.file.GetCellStyle(sheet, axis); err != nil {
+		return reflect.Value{}, err
+	}
+	return reflect.ValueOf(cell), nil
+}
+
+// readHyperlink returns the hyperlink of a cell
+func (r *StructReader) readHyperlink(col int, row int, value string) (reflect.Value, error) {
+	axis, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	_, link, err := r.Reader.file.GetCellHyperLink(r.Reader.Sheet.Name, axis)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if r.isLocation(link) {
+		link = "#" + link
+	}
+	return reflect.ValueOf(Hyperlink{URL: link, Text: value}), nil
+}
+
+// isLocation returns true if the target of a link is a location inside the workbook:
+// a cell or a range of a sheet of the workbook (ie: Sheet1!A1) or a defined name
+func (r *StructReader) isLocation(target string) bool {
+	if len(target) == 0 {
+		return false
+	}
+	file := r.Reader.file
+	if sheet, ref, ok := strings.Cut(target, "!"); ok {
+		sheet = strings.ReplaceAll(strings.Trim(sheet, "'"), "''", "'")
+		if index, err := file.GetSheetIndex(sheet); err != nil || index < 0 {
+			return false
+		}
+		for _, cell := range strings.Split(strings.ReplaceAll(ref, "$", ""), ":") {
+			if _, _, err := excelize.CellNameToCoordinates(cell); err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	for _, name := range file.GetDefinedName() {
+		if name.Name == target {
+			return true
+		}
+	}
+	return false
+}
+
+// readSource returns the value of a cell according to the source tag
+func (r *StructReader) readSource(source string, col int, row int) (string, error) {
+	axis, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return "", err
+	}
+	sheet := r.Reader.Sheet.Name
+	switch source {
+	case SourceRaw:
+		return r.Reader.file.GetCellValue(sheet, axis, excelize.Options{RawCellValue: true})
+	case SourceFormula:
+		return r.Reader.file.GetCellFormula(sheet, axis)
+	case SourceHyperlink:
+		_, link, err := r.Reader.file.GetCellHyperLink(sheet, axis)
+		return link, err
+	case SourceComment:
+		if r.comments == nil {
+			if err := r.loadComments(); err != nil {
+				return "", err
+			}
+		}
+		return r.comments[axis], nil
+	case SourceValue, "":
+		return r.Reader.file.GetCellValue(sheet, axis)
+	default:
+		return "", fmt.Errorf("excel: unknown source '%s'", source)
+	}
+}
+
+// writeCell writes the value of a field in a cell.
+// Formulas and hyperlinks are written with their dedicated excelize functions.
+func (w *StructWriter) writeCell(f *Field, cell string, row int, value reflect.Value) error {
+	file, sheet := w.Writer.file, w.Writer.Sheet.Name
+
+	// Formula template
+	if tpl := f.GetWriteFormulaTemplate(); len(tpl) > 0 {
+		w.Writer.trackCell(cell, "")
+		return file.SetCellFormula(sheet, cell, expandFormula(tpl, row))
+	}
+
+	// Nil pointers are not written
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			w.Writer.trackCell(cell, "")
+			return nil
+		}
+		if value.Elem().Type() == formulaType || value.Elem().Type() == hyperlinkType {
+			value = value.Elem()
+		}
+	}
+
+	// Annotated value written with its comment
+	if f.isAnnotated() {
+		if value.Kind() == reflect.Pointer {
+			value = value.Elem()
+		}
+		a := reflect.New(value.Type())
+		a.Elem().Set(value)
+		inner, comment := a.Interface().(annotated).annotation()
+		if inner == nil {
+			// Nil values are written as an empty cell with the comment
+			w.Writer.trackCell(cell, "")
+		} else if err := w.writeCell(f.withType(reflect.TypeOf(inner)), cell, row, reflect.ValueOf(inner)); err != nil {
+			return err
+		}
+		return w.writeComment(cell, comment)
+	}
+
+	// Invalid nullable value written as the default or as an empty cell
+	if f.isNullable(false) {
+		inner, valid := nullable(value)
+		if !valid {
+			if def := f.GetWriteDefault(); def != nil {
+				w.Writer.trackCell(cell, convert.ToString(def))
+				return file.SetCellValue(sheet, cell, def)
+			}
+			w.Writer.trackCell(cell, "")
+			return nil
+		}
+		return w.writeCell(f.withType(inner.Type()), cell, row, inner)
+	}
+
+	switch value.Type() {
+	case formulaType:
+		w.Writer.trackCell(cell, "")
+		return file.SetCellFormula(sheet, cell, expandFormula(value.String(), row))
+	case hyperlinkType:
+		link := value.Interface().(Hyperlink)
+		w.Writer.trackCell(cell, link.Text)
+		return writeHyperlink(file, sheet, cell, link)
+	}
+
+	cellValue, err := f.toCellValue(value.Interface())
+	if err != nil {
+		return fmt.Errorf("excel: failed to convert value for field '%s': %w", f.Name, err)
+	}
+
+	// Value written as a formula
+	if f.GetWriteFormula() {
+		if formula, ok := cellValue.(string); ok && len(formula) > 0 {
+			w.Writer.trackCell(cell, "")
+			return file.SetCellFormula(sheet, cell, expandFormula(formula, row))
+		}
+	}
+
+	w.Writer.trackCell(cell, convert.ToString(cellValue))
+
+	return file.SetCellValue(sheet, cell, cellValue)
+}
+
+// writeHyperlink writes a hyperlink in a cell
+func writeHyperlink(file *excelize.File, sheet string, cell string, link Hyperlink) error {
+	text := link.Text
+	if len(text) == 0 {
+		text = link.URL
+	}
+	if err := file.SetCellValue(sheet, cell, text); err != nil {
+		return err
+	}
+	if len(link.URL) == 0 {
+		return nil
+	}
+	var opts excelize.HyperlinkOpts
+	if len(link.Tooltip) > 0 {
+		opts.Tooltip = &link.Tooltip
+	}
+	if strings.HasPrefix(link.URL, "#") {
+		return file.SetCellHyperLink(sheet, cell, link.URL[1:], "Location", opts)
+	}
+	return file.SetCellHyperLink(sheet, cell, link.URL, "External", opts)
+}
+
+// expandFormula removes the leading '=' of a formula
+// and replaces the {row} placeholder by the row number
+func expandFormula(formula string, row int) string {
+	formula = strings.TrimPrefix(formula, "=")
+	return strings.ReplaceAll(formula, "{row}", strconv.Itoa(row))
+}
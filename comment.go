@@ -0,0 +1,202 @@
+package excel
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-mods/convert"
+	"github.com/xuri/excelize/v2"
+)
+
+var annotatedType = reflect.TypeOf((*annotated)(nil)).Elem()
+
+// Annotated is a value with the comment of its cell.
+// When writing, the comment is added to the cell holding the value.
+// When reading, the comment of the cell is read back with the value.
+// The value is encoded and decoded with all the tags of the field.
+//
+// Example:
+//
+//	type Product struct {
+//		Name  string                   `excel:"Name"`
+//		Price excel.Annotated[float64] `excel:"Price"`
+//	}
+type Annotated[T any] struct {
+	Value   T
+	Comment string
+}
+
+// annotated is implemented by all Annotated types
+type annotated interface {
+	annotation() (value any, comment string)
+	annotatedType() reflect.Type
+	setAnnotation(value reflect.Value, comment string)
+}
+
+func (a Annotated[T]) annotation() (any, string) {
+	return a.Value, a.Comment
+}
+
+func (a Annotated[T]) annotatedType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (a *Annotated[T]) setAnnotation(value reflect.Value, comment string) {
+	if value.IsValid() {
+		a.Value = value.Interface().(T)
+	}
+	a.Comment = comment
+}
+
+// isAnnotated returns true if the field holds an Annotated value
+func (f *Field) isAnnotated() bool {
+	t := f.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(annotatedType)
+}
+
+// withType returns a copy of the field holding a value of another type.
+// The copy shares the tags of the field.
+func (f *Field) withType(t reflect.Type) *Field {
+	inner := *f
+	inner.Type = t
+	return &inner
+}
+
+// readAnnotated reads the value and the comment of a cell
+func (r *StructReader) readAnnotated(f *Field, col int, row int, cell string) (reflect.Value, error) {
+	comment, err := r.readComment(col, row)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	t := f.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	a := reflect.New(t)
+	ann := a.Interface().(annotated)
+
+	// Decode the value with the tags of the field
+	// The comment is kept even if the value can't be decoded
+	value, err := f.withType(ann.annotatedType()).convertToValue(cell)
+	if err != nil || (value.IsValid() && !value.Type().ConvertibleTo(ann.annotatedType())) {
+		value = reflect.Value{}
+	} else if value.IsValid() {
+		value = value.Convert(ann.annotatedType())
+	}
+	ann.setAnnotation(value, comment)
+
+	return pointerTo(a.Elem(), f.Type), nil
+}
+
+// readComment returns the comment of a cell
+func (r *StructReader) readComment(col int, row int) (string, error) {
+	axis, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return "", err
+	}
+	if r.comments == nil {
+		if err := r.loadComments(); err != nil {
+			return "", err
+		}
+	}
+	return r.comments[axis], nil
+}
+
+// loadComments reads all the comments of the sheet once
+func (r *StructReader) loadComments() error {
+	comments, err := r.Reader.file.GetComments(r.Reader.Sheet.Name)
+	if err != nil {
+		return err
+	}
+	r.comments = make(map[string]string, len(comments))
+	for _, c := range comments {
+		r.comments[c.Cell] = commentText(&c)
+	}
+	return nil
+}
+
+// readCommentFrom fills the fields using the commentFrom tag
+// with the comment of the cell of the annotated field
+func (r *StructReader) readCommentFrom(container reflect.Value, row int) error {
+	for _, f := range r.Struct.Fields {
+		if f == nil || f.ReadTags.index < 0 {
+			continue
+		}
+		name := f.GetReadCommentFrom()
+		if len(name) == 0 {
+			continue
+		}
+		target := r.Struct.GetFieldByName(name)
+		if target == nil {
+			continue
+		}
+		comment, err := r.readComment(r.Reader.Axis.Col+f.ReadTags.index, row)
+		if err != nil {
+			return err
+		}
+		if len(comment) == 0 {
+			continue
+		}
+		value, err := target.convertToValue(comment)
+		if err != nil || !value.IsValid() || !value.Type().ConvertibleTo(target.Type) {
+			continue
+		}
+		if err := r.container.assign(container, target.Index, value.Convert(target.Type)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commentText returns the whole text of a comment
+func commentText(c *excelize.Comment) string {
+	if c == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(c.Text)
+	for _, run := range c.Paragraph {
+		sb.WriteString(run.Text)
+	}
+	return sb.String()
+}
+
+// writeComment replaces the comment of a cell.
+// The comment is removed if the text is empty.
+func (w *StructWriter) writeComment(cell string, text string) error {
+	file, sheet := w.Writer.file, w.Writer.Sheet.Name
+	if err := file.DeleteComment(sheet, cell); err != nil {
+		return err
+	}
+	if len(text) == 0 {
+		return nil
+	}
+	return file.AddComment(sheet, excelize.Comment{Cell: cell, Text: text})
+}
+
+// commentFrom returns the comment to write for a field using the commentFrom tag
+func (w *StructWriter) commentFrom(f *Field, values reflect.Value) (string, bool, error) {
+	name := f.GetWriteCommentFrom()
+	if len(name) == 0 {
+		return "", false, nil
+	}
+	source := w.Struct.GetFieldByName(name)
+	if source == nil {
+		return "", false, nil
+	}
+	value, err := w.container.findFieldByIndex(values, source.Index)
+	if err != nil {
+		return "", false, err
+	}
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", true, nil
+		}
+		value = value.Elem()
+	}
+	return convert.ToString(value.Interface()), true, nil
+}
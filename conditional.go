@@ -0,0 +1,219 @@
+package excel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Types of conditional formats
+const (
+	ConditionalCell       = "cell"       // fill the cells matching a criteria
+	ConditionalDataBar    = "databar"    // draw a data bar in the cells
+	ConditionalColorScale = "colorscale" // fill the cells with a 2 colors scale
+)
+
+// conditionalColors are the colors which can be given by name
+var conditionalColors = map[string]string{
+	"red":    "#FFC7CE",
+	"green":  "#C6EFCE",
+	"yellow": "#FFEB9C",
+	"orange": "#F8CBAD",
+	"blue":   "#BDD7EE",
+	"gray":   "#D9D9D9",
+}
+
+// conditionalCriteria are the criteria of the cell conditional formats.
+// Longest criteria first.
+var conditionalCriteria = []string{"<=", ">=", "<>", "!=", "==", "<", ">", "="}
+
+// ConditionalFormat is a conditional format applied to the data cells of a column.
+//
+// A conditional format can be declared with the cf tag:
+//
+//	type Product struct {
+//		Margin float64 `excel:"Margin,cf:<0:red|>0.3:green"`
+//		Stock  int     `excel:"Stock,cf:databar:blue"`
+//		Price  float64 `excel:"Price,cf:colorscale:green:red"`
+//	}
+//
+// or with the ConditionalFormats method of the ConditionalFormatter interface.
+type ConditionalFormat struct {
+	// Type is the type of the format: ConditionalCell (default),
+	// ConditionalDataBar or ConditionalColorScale
+	Type string
+	// Criteria is the comparison of a cell format: <, <=, >, >=, =, <>
+	Criteria string
+	// Value is the value compared to the cells of a cell format
+	Value string
+	// Color is the fill color of a cell format, the color of a data bar
+	// or the color of the minimum value of a color scale
+	Color string
+	// MaxColor is the color of the maximum value of a color scale
+	MaxColor string
+}
+
+// ConditionalFormatter can be implemented by the elements written by a struct writer
+// to add conditional formats to the columns.
+// The key of the map is the name of the field.
+type ConditionalFormatter interface {
+	ConditionalFormats() map[string][]ConditionalFormat
+}
+
+// ParseConditionalFormats parses the value of the cf tag.
+// Formats are separated by '|':
+//   - <criteria><value>:<color> (ie: <0:red or >=100:#FF0000)
+//   - databar[:<color>]
+//   - colorscale[:<min color>:<max color>]
+func ParseConditionalFormats(s string) ([]ConditionalFormat, error) {
+	var formats []ConditionalFormat
+	for _, rule := range strings.Split(s, "|") {
+		rule = strings.TrimSpace(rule)
+		if len(rule) == 0 {
+			continue
+		}
+		parts := strings.Split(rule, ":")
+		switch strings.ToLower(parts[0]) {
+		case ConditionalDataBar:
+			cf := ConditionalFormat{Type: ConditionalDataBar}
+			if len(parts) > 1 {
+				cf.Color = parts[1]
+			}
+			formats = append(formats, cf)
+		case ConditionalColorScale:
+			cf := ConditionalFormat{Type: ConditionalColorScale}
+			if len(parts) > 1 {
+				cf.Color = parts[1]
+			}
+			if len(parts) > 2 {
+				cf.MaxColor = parts[2]
+			}
+			formats = append(formats, cf)
+		default:
+			cf := ConditionalFormat{Type: ConditionalCell}
+			for _, criteria := range conditionalCriteria {
+				if strings.HasPrefix(parts[0], criteria) {
+					cf.Criteria = criteria
+					cf.Value = strings.TrimSpace(parts[0][len(criteria):])
+					break
+				}
+			}
+			if len(cf.Criteria) == 0 || len(cf.Value) == 0 || len(parts) != 2 {
+				return nil, fmt.Errorf("%w: '%s'", ErrConditionalFormatNotValid, rule)
+			}
+			cf.Color = parts[1]
+			formats = append(formats, cf)
+		}
+	}
+	return formats, nil
+}
+
+// options returns the excelize options of the conditional format
+func (cf ConditionalFormat) options(file *excelize.File) (excelize.ConditionalFormatOptions, error) {
+	switch cf.Type {
+	case ConditionalDataBar:
+		return excelize.ConditionalFormatOptions{
+			Type:     "data_bar",
+			Criteria: "=",
+			MinType:  "min",
+			MaxType:  "max",
+			BarColor: conditionalColor(cf.Color, "#638EC6"),
+		}, nil
+	case ConditionalColorScale:
+		return excelize.ConditionalFormatOptions{
+			Type:     "2_color_scale",
+			Criteria: "=",
+			MinType:  "min",
+			MaxType:  "max",
+			MinColor: conditionalColor(cf.Color, "#F8696B"),
+			MaxColor: conditionalColor(cf.MaxColor, "#63BE7B"),
+		}, nil
+	case ConditionalCell, "":
+		style, err := file.NewConditionalStyle(&excelize.Style{
+			Fill: excelize.Fill{
+				Type:    "pattern",
+				Pattern: 1,
+				Color:   []string{conditionalColor(cf.Color, conditionalColors["red"])},
+			},
+		})
+		if err != nil {
+			return excelize.ConditionalFormatOptions{}, err
+		}
+		return excelize.ConditionalFormatOptions{
+			Type:     "cell",
+			Criteria: cf.Criteria,
+			Value:    cf.Value,
+			Format:   style,
+		}, nil
+	default:
+		return excelize.ConditionalFormatOptions{}, fmt.Errorf("%w: unknown type '%s'", ErrConditionalFormatNotValid, cf.Type)
+	}
+}
+
+// conditionalColor returns the hexadecimal value of a color
+func conditionalColor(color string, def string) string {
+	color = strings.TrimSpace(color)
+	if len(color) == 0 {
+		return def
+	}
+	if c, ok := conditionalColors[strings.ToLower(color)]; ok {
+		return c
+	}
+	if !strings.HasPrefix(color, "#") {
+		color = "#" + color
+	}
+	return strings.ToUpper(color)
+}
+
+// writeConditionalFormats adds the conditional formats of the fields
+// to the data rows written between firstRow and lastRow
+func (w *StructWriter) writeConditionalFormats(firstRow, lastRow int) error {
+	if lastRow < firstRow {
+		return nil
+	}
+
+	// Formats returned by the element type
+	var formatter map[string][]ConditionalFormat
+	if f, ok := hookTarget(w.container.newValue()).(ConditionalFormatter); ok {
+		formatter = f.ConditionalFormats()
+	}
+
+	col, _, err := excelize.CellNameToCoordinates(w.Writer.Axis.Axis)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+
+		formats, err := ParseConditionalFormats(f.GetWriteConditionalFormat())
+		if err != nil {
+			return fmt.Errorf("excel: invalid conditional format of field '%s': %w", f.Name, err)
+		}
+		formats = append(formats, formatter[f.Name]...)
+		if len(formats) == 0 {
+			continue
+		}
+
+		opts := make([]excelize.ConditionalFormatOptions, 0, len(formats))
+		for _, cf := range formats {
+			o, err := cf.options(w.Writer.file)
+			if err != nil {
+				return fmt.Errorf("excel: invalid conditional format of field '%s': %w", f.Name, err)
+			}
+			opts = append(opts, o)
+		}
+
+		rng := Range{StartColumn: col + f.WriteTags.index, StartRow: firstRow, EndColumn: col + f.WriteTags.index, EndRow: lastRow}
+		if err := rng.UpdateNames(); err != nil {
+			return err
+		}
+		if err := w.Writer.file.SetConditionalFormat(w.Writer.Sheet.Name, rng.ToRef(), opts); err != nil {
+			return fmt.Errorf("excel: failed to set conditional format of field '%s': %w", f.Name, err)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package excel
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // Error definitions for the excel package.
 // These errors are returned by various functions in the package
@@ -9,18 +13,23 @@ var (
 	// File errors
 	ErrFileIsNil      = errors.New("excel: the file is nil")
 	ErrAxisNotValid   = errors.New("excel: the axis is not valid")
+	ErrRangeNotValid  = errors.New("excel: the range is not valid")
 	ErrConfigNotValid = errors.New("excel: the configuration is not valid")
 
 	// Sheet errors
-	ErrSheetIsNil     = errors.New("excel: the sheet is nil")
-	ErrSheetNotValid  = errors.New("excel: the sheet name is not valid")
-	ErrSheetNotFound  = errors.New("excel: the sheet is not found")
-	ErrSheetNameEmpty = errors.New("excel: the sheet name is empty")
-	ErrSheetIndex     = errors.New("excel: the sheet index is not valid")
+	ErrSheetIsNil      = errors.New("excel: the sheet is nil")
+	ErrSheetNotValid   = errors.New("excel: the sheet name is not valid")
+	ErrSheetNotFound   = errors.New("excel: the sheet is not found")
+	ErrSheetNameEmpty  = errors.New("excel: the sheet name is empty")
+	ErrSheetExists     = errors.New("excel: the sheet already exists")
+	ErrSheetIndex      = errors.New("excel: the sheet index is not valid")
+	ErrSheetVisibility = errors.New("excel: the sheet visibility is not valid")
 
 	// Table errors
 	ErrTableNameEmpty = errors.New("excel: the table name is empty")
 	ErrTableRange     = errors.New("excel: the table range is not valid")
+	ErrTableNotFound  = errors.New("excel: the table is not found")
+	ErrTableIsNil     = errors.New("excel: the table is nil")
 
 	// Container errors
 	ErrMapKeyNotString   = errors.New("excel: the map key must be a string")
@@ -32,7 +41,115 @@ var (
 
 	// Column errors
 	ErrColumnRequired = errors.New("excel: required colum")
+	ErrSchemaNotValid = errors.New("excel: the columns are not valid")
+	ErrColumnNotFound = errors.New("excel: the column is not found")
+
+	// Cell errors
+	ErrCellNotValid = errors.New("excel: the cell value is not valid")
+	ErrCellWrite    = errors.New("excel: the cell can't be written")
+
+	// Format errors
+	ErrConditionalFormatNotValid = errors.New("excel: the conditional format is not valid")
+	ErrTotalNotValid             = errors.New("excel: the total function is not valid")
+	ErrLocaleNotValid            = errors.New("excel: the locale is not valid")
+	ErrBoolNotValid              = errors.New("excel: the boolean is not valid")
 
 	// General errors
 	ErrNotImplemented = errors.New("excel: not implemented")
 )
+
+// ColumnError is returned when a column of the sheet is not valid.
+// It wraps one of the column errors (ie: ErrColumnRequired).
+type ColumnError struct {
+	// Column is the title of the column
+	Column string
+	// Field is the name of the field mapped to the column, if any
+	Field string
+	Err   error
+}
+
+func (e *ColumnError) Error() string {
+	if len(e.Field) > 0 {
+		return fmt.Sprintf("%v: column '%s' of field '%s'", e.Err, e.Column, e.Field)
+	}
+	return fmt.Sprintf("%v: column '%s'", e.Err, e.Column)
+}
+
+func (e *ColumnError) Unwrap() error {
+	return e.Err
+}
+
+// CellError is returned when a cell can't be read or written.
+// It wraps ErrCellNotValid or ErrCellWrite and the cause of the error.
+// When reading, the values which can't be converted are only reported
+// with the WithCellErrors option or in strict mode.
+type CellError struct {
+	Sheet string
+	// Cell is the reference of the cell (ie: B4)
+	Cell string
+	// Row and Col are the 1-based coordinates of the cell
+	Row int
+	Col int
+	// Value is the value of the cell
+	Value string
+	// Type is the type of the field read or written
+	Type string
+	Err  error
+}
+
+func (e *CellError) Error() string {
+	msg := fmt.Sprintf("excel: cell %s!%s", e.Sheet, e.Cell)
+	if len(e.Type) > 0 {
+		msg += fmt.Sprintf(" (%q as %s)", e.Value, e.Type)
+	}
+	return msg + ": " + strings.TrimPrefix(fmt.Sprint(e.Err), "excel: ")
+}
+
+func (e *CellError) Unwrap() error {
+	return e.Err
+}
+
+// RowError is returned when a row can't be read or written
+type RowError struct {
+	Sheet string
+	// Row is the 1-based row number in the sheet
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("excel: row %d of sheet '%s': %s", e.Row, e.Sheet, strings.TrimPrefix(fmt.Sprint(e.Err), "excel: "))
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError aggregates several errors.
+// errors.Is and errors.As look into all the aggregated errors.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	if len(messages) == 1 {
+		return messages[0]
+	}
+	return fmt.Sprintf("excel: %d errors: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+// newMultiError returns nil when there are no errors or a MultiError
+func newMultiError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
@@ -0,0 +1,270 @@
+package excel
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	bigIntType   = reflect.TypeOf((*big.Int)(nil))
+	bigFloatType = reflect.TypeOf((*big.Float)(nil))
+)
+
+// maxDigits is the number of significant digits of the numbers stored by Excel.
+// Longer numbers are written as text.
+const maxDigits = 15
+
+// durationNumFmt is the number format of the durations
+const durationNumFmt = "[h]:mm:ss"
+
+// isExact returns true if the field holds a duration, a big number or a decimal.
+// Their cells stored as numbers are read from the raw value.
+func (f *Field) isExact() bool {
+	switch f.Type {
+	case durationType, bigIntType, bigFloatType:
+		return true
+	}
+	return f.isDecimal(f.Type, f.GetReadPrecision())
+}
+
+// isDecimal returns true if a value of the type is rounded to a precision
+func (f *Field) isDecimal(t reflect.Type, precision int) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Float32, reflect.Float64:
+		return precision > 0
+	}
+	return false
+}
+
+// isInteger returns true if the kind is an integer
+func isInteger(kind reflect.Kind) bool {
+	return isNumber(kind) && kind != reflect.Float32 && kind != reflect.Float64
+}
+
+// countDigits returns the number of digits of a number, without the leading zeros
+func countDigits(s string) int {
+	n := 0
+	for _, r := range strings.TrimLeft(strings.TrimLeft(s, "-+"), "0.") {
+		if unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// decodeExact decodes the durations, the big numbers, the decimals and the long integers.
+// It returns false if the value is not exact.
+func (f *Field) decodeExact(from string, to reflect.Type) (reflect.Value, bool, error) {
+	precision := f.GetReadPrecision()
+
+	switch {
+	case to == durationType:
+		d, err := parseDuration(from)
+		return reflect.ValueOf(d), true, err
+
+	case to == bigIntType:
+		r, err := f.parseDecimal(from)
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		if !r.IsInt() {
+			return reflect.Value{}, true, fmt.Errorf("excel: '%s' is not an integer", from)
+		}
+		return reflect.ValueOf(new(big.Int).Set(r.Num())), true, nil
+
+	case to == bigFloatType:
+		r, err := f.parseDecimal(from)
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		if precision > 0 {
+			r.SetString(r.FloatString(precision))
+		}
+		return reflect.ValueOf(new(big.Float).SetRat(r)), true, nil
+
+	case f.isDecimal(to, precision):
+		r, err := f.parseDecimal(from)
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		s := r.FloatString(precision)
+		if to.Kind() == reflect.String {
+			return reflect.ValueOf(s).Convert(to), true, nil
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		return reflect.ValueOf(n).Convert(to), true, nil
+
+	case isInteger(to.Kind()) && countDigits(from) > maxDigits:
+		// Long integers are parsed without float conversion
+		l, err := f.readLocale()
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		s := normalizeNumber(strings.TrimSpace(from), l)
+		v := reflect.New(to).Elem()
+		if v.CanInt() {
+			n, err := strconv.ParseInt(s, 10, to.Bits())
+			if err != nil {
+				return reflect.Value{}, false, nil
+			}
+			v.SetInt(n)
+		} else {
+			n, err := strconv.ParseUint(s, 10, to.Bits())
+			if err != nil {
+				return reflect.Value{}, false, nil
+			}
+			v.SetUint(n)
+		}
+		return v, true, nil
+	}
+	return reflect.Value{}, false, nil
+}
+
+// parseDecimal parses an exact decimal number with the locale of the field.
+// The formatted numbers of the numfmt and currency fields (ie: "$1,200.50") are parsed as floats.
+func (f *Field) parseDecimal(from string) (*big.Rat, error) {
+	l, err := f.readLocale()
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := new(big.Rat).SetString(normalizeNumber(strings.TrimSpace(from), l)); ok {
+		return r, nil
+	}
+	if !f.isFormattedNumber() {
+		return nil, fmt.Errorf("excel: '%s' is not a decimal", from)
+	}
+	n, err := parseNumber(from, l)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).SetFloat64(n), nil
+}
+
+// parseDuration parses a Go duration (ie: 1h30m), a time (ie: 01:30:00)
+// or a fraction of day as stored by Excel (ie: 0.0625)
+func parseDuration(from string) (time.Duration, error) {
+	s := strings.TrimSpace(from)
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	// Time
+	if strings.Contains(s, ":") {
+		negative := strings.HasPrefix(s, "-")
+		parts := strings.Split(strings.TrimPrefix(s, "-"), ":")
+		if len(parts) > 3 {
+			return 0, fmt.Errorf("excel: '%s' is not a duration", from)
+		}
+		var d time.Duration
+		units := []time.Duration{time.Hour, time.Minute, time.Second}
+		for i, part := range parts {
+			n, err := strconv.ParseFloat(part, 64)
+			if err != nil || n < 0 || (i < len(parts)-1 && n != math.Trunc(n)) {
+				return 0, fmt.Errorf("excel: '%s' is not a duration", from)
+			}
+			d += time.Duration(math.Round(n * float64(units[i])))
+		}
+		if negative {
+			d = -d
+		}
+		return d, nil
+	}
+
+	// Fraction of day
+	days, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("excel: '%s' is not a duration", from)
+	}
+	ms := math.Round(days * float64(24*time.Hour/time.Millisecond))
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// encodeExact encodes the durations, the big numbers, the decimals and the long integers.
+// Durations are written as fractions of day, numbers with more than 15 digits as text.
+// It returns false if the value is not exact.
+func (f *Field) encodeExact(from any) (reflect.Value, bool, error) {
+	v := reflect.ValueOf(from)
+	if !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return reflect.Value{}, false, nil
+	}
+	precision := f.GetWritePrecision()
+
+	switch {
+	case v.Type() == durationType:
+		return reflect.ValueOf(float64(v.Int()) / float64(24*time.Hour)), true, nil
+
+	case v.Type() == bigIntType:
+		return numberOrText(v.Interface().(*big.Int).String()), true, nil
+
+	case v.Type() == bigFloatType:
+		x := v.Interface().(*big.Float)
+		if precision > 0 {
+			return numberOrText(x.Text('f', precision)), true, nil
+		}
+		return numberOrText(x.Text('f', -1)), true, nil
+
+	case f.isDecimal(v.Type(), precision):
+		var r *big.Rat
+		if v.Kind() == reflect.String {
+			if len(v.String()) == 0 {
+				return reflect.Value{}, false, nil
+			}
+			var ok bool
+			if r, ok = new(big.Rat).SetString(strings.TrimSpace(v.String())); !ok {
+				return reflect.Value{}, true, fmt.Errorf("excel: '%s' is not a decimal", v.String())
+			}
+		} else {
+			r = new(big.Rat).SetFloat64(v.Float())
+			if r == nil {
+				return reflect.Value{}, true, fmt.Errorf("excel: %v is not a decimal", v.Float())
+			}
+		}
+		return numberOrText(r.FloatString(precision)), true, nil
+
+	case v.CanInt() && countDigits(strconv.FormatInt(v.Int(), 10)) > maxDigits:
+		return reflect.ValueOf(strconv.FormatInt(v.Int(), 10)), true, nil
+
+	case v.CanUint() && countDigits(strconv.FormatUint(v.Uint(), 10)) > maxDigits:
+		return reflect.ValueOf(strconv.FormatUint(v.Uint(), 10)), true, nil
+	}
+	return reflect.Value{}, false, nil
+}
+
+// numberOrText returns a number if it can be stored by Excel without losing digits,
+// or the text of the number
+func numberOrText(s string) reflect.Value {
+	if countDigits(s) > maxDigits {
+		return reflect.ValueOf(s)
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return reflect.ValueOf(n)
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return reflect.ValueOf(n)
+	}
+	return reflect.ValueOf(s)
+}
+
+// defaultNumFmt returns the number format of the durations and the decimals
+func (f *Field) defaultNumFmt() string {
+	precision := f.GetWritePrecision()
+	switch {
+	case f.Type == durationType:
+		return durationNumFmt
+	case f.Type == bigFloatType || f.isDecimal(f.Type, precision):
+		if precision > 0 {
+			return "0." + strings.Repeat("0", precision)
+		}
+	}
+	return ""
+}
@@ -1,6 +1,8 @@
 package excel
 
 import (
+	"context"
+
 	"github.com/xuri/excelize/v2"
 )
 
@@ -22,17 +24,19 @@ type Excel struct {
 
 // NewReader creates a new Excel reader from an existing excelize.File.
 // It returns an error if the file is nil.
+// Optional options can be provided to customize the reading process.
 // The returned Excel instance can be used to unmarshal Excel data into Go structures.
 //
 // Note: The returned Excel instance is not thread-safe. If it needs to be used
 // concurrently by multiple goroutines, external synchronization is required.
-func NewReader(file *excelize.File) (*Excel, error) {
+func NewReader(file *excelize.File, opts ...Option) (*Excel, error) {
 	if file == nil {
 		return nil, ErrFileIsNil
 	}
 	r := &Reader{
 		file: file,
 	}
+	r.opts.apply(opts...)
 	e := &Excel{
 		File:   file,
 		Reader: r,
@@ -56,12 +60,23 @@ func NewReader(file *excelize.File) (*Excel, error) {
 // This method is not thread-safe. If multiple goroutines need to call Unmarshal
 // on the same Excel instance concurrently, external synchronization must be provided.
 func (e *Excel) Unmarshal(container any, tags ...map[string]*Tags) error {
+	return e.UnmarshalContext(context.Background(), container, tags...)
+}
+
+// UnmarshalContext works like Unmarshal but stops reading as soon as the context is done.
+// The context is checked between each row and its error is returned when the
+// operation is cancelled.
+func (e *Excel) UnmarshalContext(ctx context.Context, container any, tags ...map[string]*Tags) error {
 	// validate excel input
 	err := e.validate()
 	if err != nil {
 		return err
 	}
 
+	// Set the context of the operation
+	e.Reader.opts.ctx = ctx
+	defer func() { e.Reader.opts.ctx = nil }()
+
 	// Create the reader
 	reader, err := e.Reader.newReader(container)
 	if err != nil {
@@ -85,17 +100,19 @@ func (e *Excel) Unmarshal(container any, tags ...map[string]*Tags) error {
 
 // NewWriter creates a new Excel writer from an existing excelize.File.
 // It returns an error if the file is nil.
+// Optional options can be provided to customize the writing process.
 // The returned Excel instance can be used to marshal Go structures into Excel data.
 //
 // Note: The returned Excel instance is not thread-safe. If it needs to be used
 // concurrently by multiple goroutines, external synchronization is required.
-func NewWriter(file *excelize.File) (*Excel, error) {
+func NewWriter(file *excelize.File, opts ...Option) (*Excel, error) {
 	if file == nil {
 		return nil, ErrFileIsNil
 	}
 	w := &Writer{
 		file: file,
 	}
+	w.opts.apply(opts...)
 	e := &Excel{
 		File:   file,
 		Writer: w,
@@ -119,12 +136,28 @@ func NewWriter(file *excelize.File) (*Excel, error) {
 // This method is not thread-safe. If multiple goroutines need to call Marshal
 // on the same Excel instance concurrently, external synchronization must be provided.
 func (e *Excel) Marshal(container any, tags ...map[string]*Tags) error {
+	return e.MarshalContext(context.Background(), container, tags...)
+}
+
+// MarshalContext works like Marshal but stops writing as soon as the context is done.
+// The context is checked between each row and its error is returned when the
+// operation is cancelled. Rows written before the cancellation are kept in the file.
+func (e *Excel) MarshalContext(ctx context.Context, container any, tags ...map[string]*Tags) error {
 	// validate excel input
 	err := e.validate()
 	if err != nil {
 		return err
 	}
 
+	// Set the context of the operation
+	e.Writer.opts.ctx = ctx
+	defer func() { e.Writer.opts.ctx = nil }()
+
+	// Reset the written region
+	e.Writer.region = region{}
+	e.Writer.outlined = false
+	e.Writer.outline = outlineRow{}
+
 	// Create the writer
 	writer, err := e.Writer.newWriter(container)
 	if err != nil {
@@ -143,7 +176,12 @@ func (e *Excel) Marshal(container any, tags ...map[string]*Tags) error {
 
 	// unmarshall
 	e.Writer.Result, err = writer.Marshall(container)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Apply the layout options to the written region
+	return e.Writer.applyLayout()
 }
 
 // validate validates the Excel configuration.
@@ -0,0 +1,165 @@
+package excel
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-mods/convert"
+	"github.com/xuri/excelize/v2"
+)
+
+// extraField returns the field holding the columns which are not mapped to a field.
+// The field must be a map[string]string or a map[string]any.
+func (s *Struct) extraField(read bool) (*Field, error) {
+	for _, f := range s.Fields {
+		if f == nil {
+			continue
+		}
+		if (read && f.GetReadExtra()) || (!read && f.GetWriteExtra()) {
+			t := f.Type
+			if t.Kind() != reflect.Map || t.Key().Kind() != reflect.String ||
+				(t.Elem().Kind() != reflect.String && t.Elem().Kind() != reflect.Interface) {
+				return nil, fmt.Errorf("excel: extra field '%s' must be a map[string]string or a map[string]any, got %v", f.Name, t)
+			}
+			return f, nil
+		}
+	}
+	return nil, nil
+}
+
+// updateExtraColumns finds the columns of the title row which are not mapped to a field
+func (r *StructReader) updateExtraColumns() {
+	r.extraColumns = nil
+	if r.extraField == nil {
+		return
+	}
+	mapped := make(map[int]bool)
+	for _, reader := range []*StructReader{r, r.children} {
+		if reader == nil {
+			continue
+		}
+		for _, f := range reader.Struct.Fields {
+			if f != nil && f.ReadTags.index >= 0 {
+				mapped[f.ReadTags.index] = true
+			}
+		}
+	}
+	for _, columns := range r.repeatColumns {
+		for _, index := range columns {
+			mapped[index] = true
+		}
+	}
+	for index, title := range r.titles {
+		if len(strings.TrimSpace(title)) > 0 && !mapped[index] {
+			r.extraColumns = append(r.extraColumns, index)
+		}
+	}
+}
+
+// readExtra fills the extra field with the values of the columns which are not mapped to a field
+func (r *StructReader) readExtra(container reflect.Value, row []string) error {
+	if r.extraField == nil {
+		return nil
+	}
+	t := r.extraField.Type
+	extra := reflect.MakeMapWithSize(t, len(r.extraColumns))
+	for _, index := range r.extraColumns {
+		var cell string
+		if index < len(row) {
+			cell = row[index]
+		}
+		extra.SetMapIndex(reflect.ValueOf(r.titles[index]).Convert(t.Key()), reflect.ValueOf(cell).Convert(t.Elem()))
+	}
+	return r.container.assign(container, r.extraField.Index, extra)
+}
+
+// updateExtraColumns sets the columns of the keys of the extra field.
+// Keys which are not found in the title row are written, sorted, after the other columns.
+func (w *StructWriter) updateExtraColumns(row []string, data any) {
+	w.extraColumns = nil
+	if w.extraField == nil {
+		return
+	}
+
+	// Keys of all the elements
+	var keys []string
+	s := reflect.Indirect(reflect.ValueOf(data))
+	for i := 0; i < s.Len(); i++ {
+		values := reflect.Indirect(s.Index(i))
+		if !values.IsValid() {
+			continue
+		}
+		extra, err := w.container.findFieldByIndex(values, w.extraField.Index)
+		if err != nil {
+			continue
+		}
+		for _, key := range extra.MapKeys() {
+			if k := key.String(); !slices.Contains(keys, k) {
+				keys = append(keys, k)
+			}
+		}
+	}
+	slices.Sort(keys)
+
+	// Columns used by the fields
+	used, next := w.usedColumns()
+
+	w.extraColumns = make(map[string]int, len(keys))
+	for _, key := range keys {
+		if index := slices.Index(row, key); index >= 0 && !used[index] {
+			w.extraColumns[key] = index
+			next = max(next, index+1)
+		}
+	}
+	for _, key := range keys {
+		if _, ok := w.extraColumns[key]; !ok {
+			w.extraColumns[key] = next
+			next++
+		}
+	}
+}
+
+// writeExtraTitles writes the keys of the extra field in the title row
+func (w *StructWriter) writeExtraTitles(col, row int) error {
+	for key, index := range w.extraColumns {
+		cell, err := excelize.CoordinatesToCellName(col+index, row)
+		if err != nil {
+			return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+		}
+		if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, key); err != nil {
+			return fmt.Errorf("excel: failed to set cell value for title at %s: %w", cell, err)
+		}
+		w.Writer.track(col+index, row, key)
+	}
+	return nil
+}
+
+// writeExtra writes the values of the extra field of an element
+func (w *StructWriter) writeExtra(values reflect.Value, col, row int) error {
+	if w.extraField == nil {
+		return nil
+	}
+	extra, err := w.container.findFieldByIndex(values, w.extraField.Index)
+	if err != nil {
+		return fmt.Errorf("excel: failed to find field at index %d: %w", w.extraField.Index, err)
+	}
+	iter := extra.MapRange()
+	for iter.Next() {
+		index, ok := w.extraColumns[iter.Key().String()]
+		if !ok {
+			continue
+		}
+		cell, err := excelize.CoordinatesToCellName(col+index, row)
+		if err != nil {
+			return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+		}
+		value := iter.Value().Interface()
+		if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, value); err != nil {
+			return fmt.Errorf("excel: failed to set cell value at %s: %w", cell, err)
+		}
+		w.Writer.trackCell(cell, convert.ToString(value))
+	}
+	return nil
+}
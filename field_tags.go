@@ -40,6 +40,14 @@ func (f *Field) GetReadSplit() string {
 	return f.MainTags.Split
 }
 
+// GetReadKVSep returns the separator of the keys and the values of a map when reading the cell
+func (f *Field) GetReadKVSep() string {
+	if len(f.ReadTags.KVSep) > 0 {
+		return f.ReadTags.KVSep
+	}
+	return f.MainTags.KVSep
+}
+
 // GetReadRequired returns whether the field is required when reading the cell
 func (f *Field) GetReadRequired() bool {
 	if f.ReadTags.Required {
@@ -56,6 +64,118 @@ func (f *Field) GetReadIgnore() bool {
 	return f.MainTags.Ignore
 }
 
+// GetReadOptional returns whether the column may be missing when reading in strict mode
+func (f *Field) GetReadOptional() bool {
+	if f.ReadTags.Optional {
+		return f.ReadTags.Optional
+	}
+	return f.MainTags.Optional
+}
+
+// GetReadRepeat returns the title of the repeated columns of a slice field
+func (f *Field) GetReadRepeat() string {
+	if len(f.ReadTags.Repeat) > 0 {
+		return f.ReadTags.Repeat
+	}
+	return f.MainTags.Repeat
+}
+
+// GetReadLocale returns the locale of the numbers when reading the cell
+func (f *Field) GetReadLocale() string {
+	if len(f.ReadTags.Locale) > 0 {
+		return f.ReadTags.Locale
+	}
+	return f.MainTags.Locale
+}
+
+// GetReadBool returns the labels of the booleans when reading the cell
+func (f *Field) GetReadBool() string {
+	if len(f.ReadTags.Bool) > 0 {
+		return f.ReadTags.Bool
+	}
+	return f.MainTags.Bool
+}
+
+// GetReadPercent returns whether the cell holds a percent when reading
+func (f *Field) GetReadPercent() bool {
+	if f.ReadTags.Percent {
+		return f.ReadTags.Percent
+	}
+	return f.MainTags.Percent
+}
+
+// GetReadNumFmt returns the number format of the cells when reading
+func (f *Field) GetReadNumFmt() string {
+	if len(f.ReadTags.NumFmt) > 0 {
+		return f.ReadTags.NumFmt
+	}
+	return f.MainTags.NumFmt
+}
+
+// GetReadCurrency returns the currency of the cells when reading
+func (f *Field) GetReadCurrency() string {
+	if len(f.ReadTags.Currency) > 0 {
+		return f.ReadTags.Currency
+	}
+	return f.MainTags.Currency
+}
+
+// GetReadPrecision returns the number of decimals of the exact decimals when reading the cell
+func (f *Field) GetReadPrecision() int {
+	if f.ReadTags.Precision > 0 {
+		return f.ReadTags.Precision
+	}
+	return f.MainTags.Precision
+}
+
+// GetReadSource returns the source of the value to read from the cell
+func (f *Field) GetReadSource() string {
+	if len(f.ReadTags.Source) > 0 {
+		return f.ReadTags.Source
+	}
+	return f.MainTags.Source
+}
+
+// GetReadCommentFrom returns the name of the field filled with the comment of the cell
+func (f *Field) GetReadCommentFrom() string {
+	if len(f.ReadTags.CommentFrom) > 0 {
+		return f.ReadTags.CommentFrom
+	}
+	return f.MainTags.CommentFrom
+}
+
+// GetReadChildren returns true if the field holds the detail rows
+func (f *Field) GetReadChildren() bool {
+	if f.ReadTags.Children {
+		return f.ReadTags.Children
+	}
+	return f.MainTags.Children
+}
+
+// GetReadKey returns true if the field identifies the parent of the detail rows
+func (f *Field) GetReadKey() bool {
+	if f.ReadTags.Key {
+		return f.ReadTags.Key
+	}
+	return f.MainTags.Key
+}
+
+// GetReadMeta returns the metadata of the row filling the field
+func (f *Field) GetReadMeta() string {
+	if len(f.ReadTags.Meta) > 0 {
+		return f.ReadTags.Meta
+	}
+	return f.MainTags.Meta
+}
+
+// GetReadExtra returns true if the field holds the columns which are not mapped to a field
+func (f *Field) GetReadExtra() bool {
+	if f.ReadTags.Extra {
+		return f.ReadTags.Extra
+	}
+	return f.MainTags.Extra
+}
+
 // GetWriteColumnName returns the column name to write to the excel file
 func (f *Field) GetWriteColumnName() string {
 	if len(f.WriteTags.Column) > 0 {
@@ -96,6 +216,14 @@ func (f *Field) GetWriteSplit() string {
 	return f.MainTags.Split
 }
 
+// GetWriteKVSep returns the separator of the keys and the values of a map when writing the cell
+func (f *Field) GetWriteKVSep() string {
+	if len(f.WriteTags.KVSep) > 0 {
+		return f.WriteTags.KVSep
+	}
+	return f.MainTags.KVSep
+}
+
 // GetWriteRequired returns whether the field is required when writing the cell
 func (f *Field) GetWriteRequired() bool {
 	if f.WriteTags.Required {
@@ -111,3 +239,131 @@ func (f *Field) GetWriteIgnore() bool {
 	}
 	return f.MainTags.Ignore
 }
+
+// GetWriteFormula returns whether the value must be written as a formula
+func (f *Field) GetWriteFormula() bool {
+	if f.WriteTags.Formula {
+		return f.WriteTags.Formula
+	}
+	return f.MainTags.Formula
+}
+
+// GetWriteFormulaTemplate returns the formula to write on each row
+func (f *Field) GetWriteFormulaTemplate() string {
+	if len(f.WriteTags.FormulaTemplate) > 0 {
+		return f.WriteTags.FormulaTemplate
+	}
+	return f.MainTags.FormulaTemplate
+}
+
+// GetWriteCommentFrom returns the name of the field holding the comment of the cell
+func (f *Field) GetWriteCommentFrom() string {
+	if len(f.WriteTags.CommentFrom) > 0 {
+		return f.WriteTags.CommentFrom
+	}
+	return f.MainTags.CommentFrom
+}
+
+// GetWriteNumFmt returns the number format of the cells
+func (f *Field) GetWriteNumFmt() string {
+	if len(f.WriteTags.NumFmt) > 0 {
+		return f.WriteTags.NumFmt
+	}
+	return f.MainTags.NumFmt
+}
+
+// GetWriteCurrency returns the currency of the cells
+func (f *Field) GetWriteCurrency() string {
+	if len(f.WriteTags.Currency) > 0 {
+		return f.WriteTags.Currency
+	}
+	return f.MainTags.Currency
+}
+
+// GetWriteWidth returns the width of the column
+func (f *Field) GetWriteWidth() float64 {
+	if f.WriteTags.Width > 0 {
+		return f.WriteTags.Width
+	}
+	return f.MainTags.Width
+}
+
+// GetWriteConditionalFormat returns the conditional formats of the column
+func (f *Field) GetWriteConditionalFormat() string {
+	if len(f.WriteTags.ConditionalFormat) > 0 {
+		return f.WriteTags.ConditionalFormat
+	}
+	return f.MainTags.ConditionalFormat
+}
+
+// GetWriteTotal returns the function of the totals row
+func (f *Field) GetWriteTotal() string {
+	if len(f.WriteTags.Total) > 0 {
+		return f.WriteTags.Total
+	}
+	return f.MainTags.Total
+}
+
+// GetWriteChildren returns true if the field holds the detail rows
+func (f *Field) GetWriteChildren() bool {
+	if f.WriteTags.Children {
+		return f.WriteTags.Children
+	}
+	return f.MainTags.Children
+}
+
+// GetWriteHidden returns true if the column must be hidden
+func (f *Field) GetWriteHidden() bool {
+	if f.WriteTags.Hidden {
+		return f.WriteTags.Hidden
+	}
+	return f.MainTags.Hidden
+}
+
+// GetWriteExtra returns true if the field holds the columns which are not mapped to a field
+func (f *Field) GetWriteExtra() bool {
+	if f.WriteTags.Extra {
+		return f.WriteTags.Extra
+	}
+	return f.MainTags.Extra
+}
+
+// GetWriteRepeat returns the title of the repeated columns of a slice field
+func (f *Field) GetWriteRepeat() string {
+	if len(f.WriteTags.Repeat) > 0 {
+		return f.WriteTags.Repeat
+	}
+	return f.MainTags.Repeat
+}
+
+// GetWriteLocale returns the locale of the numbers written as text
+func (f *Field) GetWriteLocale() string {
+	if len(f.WriteTags.Locale) > 0 {
+		return f.WriteTags.Locale
+	}
+	return f.MainTags.Locale
+}
+
+// GetWriteBool returns the labels of the booleans when writing the cell
+func (f *Field) GetWriteBool() string {
+	if len(f.WriteTags.Bool) > 0 {
+		return f.WriteTags.Bool
+	}
+	return f.MainTags.Bool
+}
+
+// GetWritePercent returns whether the localized numbers are written as percents
+func (f *Field) GetWritePercent() bool {
+	if f.WriteTags.Percent {
+		return f.WriteTags.Percent
+	}
+	return f.MainTags.Percent
+}
+
+// GetWritePrecision returns the number of decimals of the exact decimals when writing the cell
+func (f *Field) GetWritePrecision() int {
+	if f.WriteTags.Precision > 0 {
+		return f.WriteTags.Precision
+	}
+	return f.MainTags.Precision
+}
@@ -3,6 +3,7 @@ package excel
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,12 @@ var timeType = reflect.TypeOf((*time.Time)(nil)).Elem()
 
 const defaultSplitChar = ","
 
+// defaultMapSplitChar and defaultKVSepChar are the separators of the maps held in a cell (ie: k1=v1;k2=v2)
+const (
+	defaultMapSplitChar = ";"
+	defaultKVSepChar    = "="
+)
+
 // convertToValue is called when reading an Excel file to get the value of a field
 func (f *Field) convertToValue(from string) (value reflect.Value, err error) {
 
@@ -71,6 +78,54 @@ func (f *Field) convertToValue(from string) (value reflect.Value, err error) {
 		return
 	}
 
+	// Decode the value of the field if it is a map of keys and values
+	if f.Type.Kind() == reflect.Map && f.GetReadEncoding() != "json" {
+		value = reflect.MakeMap(f.Type)
+		if len(strings.TrimSpace(from)) == 0 {
+			return value, nil
+		}
+
+		splitChar, kvSep := f.GetReadSplit(), f.GetReadKVSep()
+		if splitChar == "" {
+			splitChar = defaultMapSplitChar
+		}
+		if kvSep == "" {
+			kvSep = defaultKVSepChar
+		}
+
+		for _, pair := range strings.Split(from, splitChar) {
+			if len(strings.TrimSpace(pair)) == 0 {
+				continue
+			}
+			ks, vs, ok := strings.Cut(pair, kvSep)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("excel: '%s' is not a key/value pair", pair)
+			}
+			if len(strings.TrimSpace(ks)) == 0 {
+				return reflect.Value{}, fmt.Errorf("excel: the key of the pair '%s' is empty", pair)
+			}
+			k, err := f.decode(strings.TrimSpace(ks), f.Type.Key())
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("excel: failed to decode map key '%s': %w", ks, err)
+			}
+			if !k.IsValid() || !k.Type().ConvertibleTo(f.Type.Key()) {
+				return reflect.Value{}, fmt.Errorf("excel: '%s' is not a valid map key of type %v", ks, f.Type.Key())
+			}
+			v, err := f.decode(strings.TrimSpace(vs), f.Type.Elem())
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("excel: failed to decode map value of key '%s': %w", ks, err)
+			}
+			if !v.IsValid() {
+				v = reflect.Zero(f.Type.Elem())
+			}
+			if !v.Type().ConvertibleTo(f.Type.Elem()) {
+				return reflect.Value{}, fmt.Errorf("excel: '%s' is not a valid map value of type %v", vs, f.Type.Elem())
+			}
+			value.SetMapIndex(k.Convert(f.Type.Key()), v.Convert(f.Type.Elem()))
+		}
+		return value, nil
+	}
+
 	// Decode the value of the field if it is a pointer
 	if f.Type.Kind() == reflect.Pointer {
 		value, err = f.decode(from, f.Type)
@@ -89,6 +144,13 @@ func (f *Field) convertToValue(from string) (value reflect.Value, err error) {
 	return
 }
 
+// decodeWritten decodes a value encoded with the write tags of the field
+func (f *Field) decodeWritten(from string) (reflect.Value, error) {
+	written := *f
+	written.ReadTags = f.WriteTags
+	return written.convertToValue(from)
+}
+
 // decode is called when reading an Excel file to get the value of a field
 func (f *Field) decode(from string, to reflect.Type) (value reflect.Value, err error) {
 	// Validate to type is not nil
@@ -126,8 +188,28 @@ func (f *Field) decode(from string, to reflect.Type) (value reflect.Value, err e
 					}
 				}
 				value = reflect.ValueOf(defaultValue)
+			} else if exact, ok, err := f.decodeExact(from, to); ok {
+				if err != nil {
+					return reflect.Value{}, fmt.Errorf("excel: failed to convert '%s' to type %v: %w", from, to, err)
+				}
+				value = exact
+			} else if localized, ok, err := f.decodeLocalized(from, to); ok {
+				if err != nil {
+					return reflect.Value{}, fmt.Errorf("excel: failed to convert '%s' to type %v: %w", from, to, err)
+				}
+				value = localized
 			} else {
 				value, err = convert.ToValueE(from, to)
+				if err != nil && isNumber(to.Kind()) && f.isFormattedNumber() {
+					// Formatted numbers of the numfmt and currency fields (ie: "$1,200.00", "(45.00)")
+					var l numberLocale
+					var n float64
+					if l, err = f.readLocale(); err == nil {
+						if n, err = parseNumber(from, l); err == nil {
+							value, _, err = numberValue(n, to)
+						}
+					}
+				}
 				if err != nil {
 					return reflect.Value{}, fmt.Errorf("excel: failed to convert '%s' to type %v: %w", from, to, err)
 				}
@@ -201,11 +283,56 @@ func (f *Field) toCellValue(from interface{}) (interface{}, error) {
 		return strings.Join(values, splitChar), nil
 	}
 
+	// Encode the Value if it is a map of keys and values, sorted by key
+	if f.Type.Kind() == reflect.Map && f.GetWriteEncoding() != "json" {
+		m := reflect.ValueOf(from)
+		if m.Len() == 0 {
+			return f.GetWriteDefault(), nil
+		}
+
+		splitChar, kvSep := f.GetWriteSplit(), f.GetWriteKVSep()
+		if splitChar == "" {
+			splitChar = defaultMapSplitChar
+		}
+		if kvSep == "" {
+			kvSep = defaultKVSepChar
+		}
+
+		keys := m.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(convert.ToString(a.Interface()), convert.ToString(b.Interface()))
+		})
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			vs, err := f.encode(m.MapIndex(k).Interface(), reflect.TypeOf(""))
+			if err != nil {
+				return nil, fmt.Errorf("excel: failed to encode map value of key '%v': %w", k, err)
+			}
+			// The separators can't be read back from a key, nor the split from a value
+			ks, value := convert.ToString(k.Interface()), convert.ToString(vs)
+			if strings.Contains(ks, splitChar) || strings.Contains(ks, kvSep) {
+				return nil, fmt.Errorf("excel: the map key '%s' contains the separator '%s' or '%s'", ks, splitChar, kvSep)
+			}
+			if strings.Contains(value, splitChar) {
+				return nil, fmt.Errorf("excel: the map value of key '%s' contains the separator '%s'", ks, splitChar)
+			}
+			pairs = append(pairs, ks+kvSep+value)
+		}
+		return strings.Join(pairs, splitChar), nil
+	}
+
 	// Encode the Value if it is a pointer
 	if f.Type.Kind() == reflect.Pointer {
-		if from == nil {
+		if from == nil || reflect.ValueOf(from).IsNil() {
 			return f.GetWriteDefault(), nil
 		}
+		if f.Type == bigIntType || f.Type == bigFloatType {
+			encoded, err := f.encode(from, f.Type)
+			if err != nil {
+				return nil, fmt.Errorf("excel: failed to encode value: %w", err)
+			}
+			return encoded.Interface(), nil
+		}
 		return from, nil
 	}
 
@@ -255,6 +382,16 @@ func (f *Field) encode(from interface{}, fieldType reflect.Type) (value reflect.
 				return reflect.ValueOf(s), nil
 			}
 			return reflect.ValueOf(dt), nil
+		} else if localized, ok, err := f.encodeLocalized(from); ok {
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("excel: failed to convert value to text: %w", err)
+			}
+			return localized, nil
+		} else if exact, ok, err := f.encodeExact(from); ok {
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("excel: failed to convert value to number: %w", err)
+			}
+			return exact, nil
 		} else {
 			value, err = convert.ToValueE(from, fieldType)
 			if err != nil {
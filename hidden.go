@@ -0,0 +1,132 @@
+package excel
+
+import (
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// SheetVisibility is the visibility of a sheet
+type SheetVisibility string
+
+// Visibilities of a sheet
+const (
+	SheetVisible    SheetVisibility = "visible"
+	SheetHidden     SheetVisibility = "hidden"
+	SheetVeryHidden SheetVisibility = "veryHidden" // can only be shown again by code
+)
+
+// WithSkipHiddenRows skips the hidden data rows when reading
+func WithSkipHiddenRows() Option {
+	return func(o *options) {
+		o.skipHiddenRows = true
+	}
+}
+
+// WithSkipHiddenColumns skips the hidden columns when reading.
+// The data cells of the hidden columns are read as blank cells.
+// Their titles are kept, so the fields stay mapped to the columns.
+func WithSkipHiddenColumns() Option {
+	return func(o *options) {
+		o.skipHiddenColumns = true
+	}
+}
+
+// isRowHidden returns true if a row of the sheet is hidden
+func (r *Reader) isRowHidden(row int) (bool, error) {
+	visible, err := r.file.GetRowVisible(r.Sheet.Name, row)
+	return !visible, err
+}
+
+// hideColumns blanks the cells of the hidden columns of a row.
+// hidden caches the visibility of the columns by column number.
+func (r *Reader) hideColumns(row []string, hidden map[int]bool) ([]string, error) {
+	var copied bool
+	for i := range row {
+		col := i + 1
+		isHidden, ok := hidden[col]
+		if !ok {
+			name, err := excelize.ColumnNumberToName(col)
+			if err != nil {
+				return nil, err
+			}
+			visible, err := r.file.GetColVisible(r.Sheet.Name, name)
+			if err != nil {
+				return nil, err
+			}
+			isHidden = !visible
+			hidden[col] = isHidden
+		}
+		if isHidden && len(row[i]) > 0 {
+			// The row may be shared, it is copied before being changed
+			if !copied {
+				row = append([]string(nil), row...)
+				copied = true
+			}
+			row[i] = ""
+		}
+	}
+	return row, nil
+}
+
+// writeHiddenColumn hides the column of a field when it has the hidden tag
+func (w *Writer) writeHiddenColumn(f *Field, col int) error {
+	if !f.GetWriteHidden() {
+		return nil
+	}
+	name, err := excelize.ColumnNumberToName(col)
+	if err != nil {
+		return err
+	}
+	return w.file.SetColVisible(w.Sheet.Name, name, false)
+}
+
+// Visibility returns the visibility of the sheet
+func (s *Sheet) Visibility() (SheetVisibility, error) {
+	if err := s.IsValidError(); err != nil {
+		return "", err
+	}
+	visible, err := s.file.GetSheetVisible(s.Name)
+	if err != nil {
+		return "", err
+	}
+	if visible {
+		return SheetVisible, nil
+	}
+	if s.file.WorkBook != nil {
+		for _, sheet := range s.file.WorkBook.Sheets.Sheet {
+			if strings.EqualFold(sheet.Name, s.Name) && sheet.State == string(SheetVeryHidden) {
+				return SheetVeryHidden, nil
+			}
+		}
+	}
+	return SheetHidden, nil
+}
+
+// SetVisibility sets the visibility of the sheet.
+// The active sheet and the last visible sheet of a workbook can't be hidden.
+func (s *Sheet) SetVisibility(visibility SheetVisibility) error {
+	if err := s.IsValidError(); err != nil {
+		return err
+	}
+	switch visibility {
+	case SheetVisible:
+		return s.file.SetSheetVisible(s.Name, true)
+	case SheetHidden:
+		return s.file.SetSheetVisible(s.Name, false)
+	case SheetVeryHidden:
+		return s.file.SetSheetVisible(s.Name, false, true)
+	default:
+		return ErrSheetVisibility
+	}
+}
+
+// Hide hides the sheet
+func (s *Sheet) Hide() error {
+	return s.SetVisibility(SheetHidden)
+}
+
+// Show shows the sheet
+func (s *Sheet) Show() error {
+	return s.SetVisibility(SheetVisible)
+}
@@ -0,0 +1,49 @@
+package excel
+
+import "reflect"
+
+// BeforeUnmarshalRowHook can be implemented by the element type of the container.
+// BeforeUnmarshalRow is called on the new element before the row is decoded into it.
+// row is the 1-based row number in the Excel sheet.
+// Returning an error stops the reading.
+type BeforeUnmarshalRowHook interface {
+	BeforeUnmarshalRow(row int) error
+}
+
+// AfterUnmarshalRowHook can be implemented by the element type of the container.
+// AfterUnmarshalRow is called once the row has been decoded into the element
+// and can be used to normalise or validate the element.
+// row is the 1-based row number in the Excel sheet.
+// Returning an error stops the reading.
+type AfterUnmarshalRowHook interface {
+	AfterUnmarshalRow(row int) error
+}
+
+// BeforeMarshalRowHook can be implemented by the element type of the container.
+// BeforeMarshalRow is called on each element before it is written.
+// Returning an error stops the writing.
+type BeforeMarshalRowHook interface {
+	BeforeMarshalRow() error
+}
+
+// hookTarget returns the value on which the hooks must be looked for.
+// The address of the value is used when possible so that
+// hooks defined with a pointer receiver are found.
+func hookTarget(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		return v.Interface()
+	}
+	if v.CanAddr() {
+		return v.Addr().Interface()
+	}
+	if v.CanInterface() {
+		return v.Interface()
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package excel
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// LocaleDefault is the value of a bare locale tag.
+// The field uses the locale given with the WithLocale option.
+const LocaleDefault = "default"
+
+// numberLocale are the conventions used to write numbers in a language
+type numberLocale struct {
+	decimal string
+	group   string
+	// percentSpace is true if a space is written before the percent sign
+	percentSpace bool
+}
+
+// locales are the supported locales, by language or language and region
+var locales = map[string]numberLocale{
+	"en":    {decimal: ".", group: ","},
+	"fr":    {decimal: ",", group: " ", percentSpace: true},
+	"fr-CH": {decimal: ".", group: "'", percentSpace: true},
+	"de":    {decimal: ",", group: ".", percentSpace: true},
+	"de-CH": {decimal: ".", group: "'"},
+	"es":    {decimal: ",", group: "."},
+	"it":    {decimal: ",", group: "."},
+	"nl":    {decimal: ",", group: "."},
+	"pt":    {decimal: ",", group: "."},
+}
+
+// WithLocale sets the locale of the numbers of all the fields (ie: fr-FR).
+// When reading, numbers like "1 234,56" are parsed with the conventions of the locale.
+// When writing, the numbers of the fields with a bare locale tag are written as text
+// with the conventions of the locale.
+func WithLocale(locale string) Option {
+	return func(o *options) {
+		o.locale = locale
+	}
+}
+
+// lookupLocale returns the conventions of a locale (ie: fr-FR, fr_FR or fr)
+func lookupLocale(name string) (numberLocale, error) {
+	name = strings.ReplaceAll(name, "_", "-")
+	if l, ok := locales[name]; ok {
+		return l, nil
+	}
+	if language, _, ok := strings.Cut(name, "-"); ok {
+		if l, ok := locales[strings.ToLower(language)]; ok {
+			return l, nil
+		}
+	}
+	if l, ok := locales[strings.ToLower(name)]; ok {
+		return l, nil
+	}
+	return numberLocale{}, fmt.Errorf("%w: '%s'", ErrLocaleNotValid, name)
+}
+
+// isNumber returns true if the kind is an integer or a float
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// applyLocale sets the locale given with the WithLocale option to the fields.
+// When reading, it is the locale of all the fields without locale.
+// When writing, it is the locale of the fields with a bare locale tag.
+func (s *Struct) applyLocale(locale string, read bool) {
+	if len(locale) == 0 {
+		return
+	}
+	for _, f := range s.Fields {
+		if f == nil {
+			continue
+		}
+		if read && (len(f.GetReadLocale()) == 0 || f.GetReadLocale() == LocaleDefault) {
+			f.ReadTags.Locale = locale
+		}
+		if !read && f.GetWriteLocale() == LocaleDefault {
+			f.WriteTags.Locale = locale
+		}
+	}
+}
+
+// isLocalized returns true if the numbers of the field are read with a locale or as percents
+func (f *Field) isLocalized() bool {
+	return isNumber(indirectType(f.Type).Kind()) && (len(f.GetReadLocale()) > 0 || f.GetReadPercent())
+}
+
+// withoutLocale returns a copy of the field reading the numbers without locale
+func (f *Field) withoutLocale() *Field {
+	plain := *f
+	main, read := *f.MainTags, *f.ReadTags
+	main.Locale, read.Locale = "", ""
+	main.Percent, read.Percent = false, false
+	plain.MainTags, plain.ReadTags = &main, &read
+	return &plain
+}
+
+// isNumberCell returns true if the cell is stored as a number
+func (r *StructReader) isNumberCell(col int, row int) (bool, error) {
+	axis, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return false, err
+	}
+	t, err := r.Reader.file.GetCellType(r.Reader.Sheet.Name, axis)
+	return t == excelize.CellTypeUnset || t == excelize.CellTypeNumber, err
+}
+
+// indirectType returns the type pointed to by a pointer type
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
+
+// parseBools parses the labels of the booleans (ie: Oui:true|Non:false)
+func parseBools(s string) (map[string]bool, error) {
+	bools := make(map[string]bool)
+	for _, pair := range strings.Split(s, "|") {
+		label, value, ok := strings.Cut(pair, ":")
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if !ok || err != nil {
+			return nil, fmt.Errorf("%w: '%s'", ErrBoolNotValid, pair)
+		}
+		bools[strings.ToLower(strings.TrimSpace(label))] = b
+	}
+	return bools, nil
+}
+
+// boolLabel returns the first label of a boolean (ie: Oui for true)
+func boolLabel(s string, value bool) (string, bool) {
+	for _, pair := range strings.Split(s, "|") {
+		label, v, _ := strings.Cut(pair, ":")
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil && b == value {
+			return strings.TrimSpace(label), true
+		}
+	}
+	return "", false
+}
+
+// decodeLocalized decodes the localized booleans, numbers and percents.
+// It returns false if the value is not localized.
+func (f *Field) decodeLocalized(from string, to reflect.Type) (reflect.Value, bool, error) {
+	// Booleans
+	if labels := f.GetReadBool(); len(labels) > 0 && to.Kind() == reflect.Bool {
+		bools, err := parseBools(labels)
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		b, ok := bools[strings.ToLower(strings.TrimSpace(from))]
+		if !ok {
+			return reflect.Value{}, true, fmt.Errorf("%w: '%s'", ErrBoolNotValid, from)
+		}
+		return reflect.ValueOf(b).Convert(to), true, nil
+	}
+
+	locale, percent := f.GetReadLocale(), f.GetReadPercent()
+	if !isNumber(to.Kind()) || (len(locale) == 0 && !percent) {
+		return reflect.Value{}, false, nil
+	}
+
+	// Numbers
+	l, err := f.readLocale()
+	if err != nil {
+		return reflect.Value{}, true, err
+	}
+	n, err := parseNumber(from, l)
+	if err != nil {
+		return reflect.Value{}, true, err
+	}
+	return numberValue(n, to)
+}
+
+// readLocale returns the conventions of the numbers read in the field
+func (f *Field) readLocale() (numberLocale, error) {
+	if locale := f.GetReadLocale(); len(locale) > 0 && locale != LocaleDefault {
+		return lookupLocale(locale)
+	}
+	return locales["en"], nil
+}
+
+// normalizeNumber removes the group separators and the spaces of a number
+// and uses a dot as decimal separator
+func normalizeNumber(s string, l numberLocale) string {
+	s = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "").Replace(s)
+	if len(strings.TrimSpace(l.group)) > 0 {
+		s = strings.ReplaceAll(s, l.group, "")
+	}
+	return strings.Replace(s, l.decimal, ".", 1)
+}
+
+// numberValue converts a float to an integer or a float type
+func numberValue(n float64, to reflect.Type) (reflect.Value, bool, error) {
+	switch to.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(n).Convert(to), true, nil
+	}
+	if n != math.Trunc(n) {
+		return reflect.Value{}, true, fmt.Errorf("excel: %v is not an integer", n)
+	}
+	v := reflect.New(to).Elem()
+	if v.CanInt() {
+		if v.OverflowInt(int64(n)) {
+			return reflect.Value{}, true, fmt.Errorf("excel: %v overflows %v", n, to)
+		}
+		v.SetInt(int64(n))
+	} else {
+		if n < 0 || v.OverflowUint(uint64(n)) {
+			return reflect.Value{}, true, fmt.Errorf("excel: %v overflows %v", n, to)
+		}
+		v.SetUint(uint64(n))
+	}
+	return v, true, nil
+}
+
+// encodeLocalized encodes the localized booleans and numbers as text.
+// It returns false if the value is not localized.
+func (f *Field) encodeLocalized(from any) (reflect.Value, bool, error) {
+	v := reflect.ValueOf(from)
+	if !v.IsValid() {
+		return reflect.Value{}, false, nil
+	}
+
+	// Booleans
+	if labels := f.GetWriteBool(); len(labels) > 0 && v.Kind() == reflect.Bool {
+		label, ok := boolLabel(labels, v.Bool())
+		if !ok {
+			return reflect.Value{}, true, fmt.Errorf("%w: no label for %v in '%s'", ErrBoolNotValid, v.Bool(), labels)
+		}
+		return reflect.ValueOf(label), true, nil
+	}
+
+	// Numbers written as text
+	locale := f.GetWriteLocale()
+	if !isNumber(v.Kind()) || len(locale) == 0 || locale == LocaleDefault {
+		return reflect.Value{}, false, nil
+	}
+	l, err := lookupLocale(locale)
+	if err != nil {
+		return reflect.Value{}, true, err
+	}
+	n := reflect.ValueOf(from).Convert(reflect.TypeOf(float64(0))).Float()
+	if f.GetWritePercent() {
+		s := formatNumber(n*100, l)
+		if l.percentSpace {
+			return reflect.ValueOf(s + " %"), true, nil
+		}
+		return reflect.ValueOf(s + "%"), true, nil
+	}
+	return reflect.ValueOf(formatNumber(n, l)), true, nil
+}
+
+// formatNumber formats a number with the separators of a locale
+func formatNumber(n float64, l numberLocale) string {
+	s := strconv.FormatFloat(n, 'f', -1, 64)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	integer, fraction, _ := strings.Cut(s, ".")
+
+	var sb strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			sb.WriteString(l.group)
+		}
+		sb.WriteRune(digit)
+	}
+	if len(fraction) > 0 {
+		sb.WriteString(l.decimal)
+		sb.WriteString(fraction)
+	}
+	return sign + sb.String()
+}
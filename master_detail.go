@@ -0,0 +1,236 @@
+package excel
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithMergeParentCells merges the cells of the parent fields written
+// on the rows of their children. Parent values are written once.
+// Merged parent cells are read back as if the values were repeated.
+func WithMergeParentCells() Option {
+	return func(o *options) {
+		o.mergeParents = true
+	}
+}
+
+// childrenField returns the field holding the detail rows of a master-detail struct
+func (s *Struct) childrenField(read bool) (*Field, error) {
+	for _, f := range s.Fields {
+		if f == nil {
+			continue
+		}
+		if (read && f.GetReadChildren()) || (!read && f.GetWriteChildren()) {
+			t := f.Type
+			if t.Kind() != reflect.Slice {
+				return nil, fmt.Errorf("excel: children field '%s' must be a slice, got %v", f.Name, t.Kind())
+			}
+			return f, nil
+		}
+	}
+	return nil, nil
+}
+
+// newChildrenReader creates the reader of the detail rows of a master-detail struct
+func (r *StructReader) newChildrenReader() error {
+	f, err := r.Struct.childrenField(true)
+	if err != nil || f == nil {
+		return err
+	}
+	children, err := newStructReader(r.Reader, reflect.New(f.Type))
+	if err != nil {
+		return fmt.Errorf("excel: failed to create reader of children field '%s': %w", f.Name, err)
+	}
+	r.children = children
+	r.childrenField = f
+	return nil
+}
+
+// parentKey returns the key identifying the parent of a detail row.
+// The key is made of the key fields or, if none, of all the parent fields.
+func (r *StructReader) parentKey(row []string) string {
+	var keys, all []string
+	for _, f := range r.Struct.Fields {
+		if f == nil || f.ReadTags.index < 0 {
+			continue
+		}
+		var cell string
+		if f.ReadTags.index < len(row) {
+			cell = strings.TrimSpace(row[f.ReadTags.index])
+		}
+		all = append(all, cell)
+		if f.GetReadKey() {
+			keys = append(keys, cell)
+		}
+	}
+	if keys == nil {
+		keys = all
+	}
+	if isEmptyRow(keys) {
+		return ""
+	}
+	return strings.Join(keys, "\x1f")
+}
+
+// isChildEmpty returns true if all the cells of the children fields are blank
+func (r *StructReader) isChildEmpty(row []string) bool {
+	for _, f := range r.children.Struct.Fields {
+		if f == nil || f.ReadTags.index < 0 || f.ReadTags.index >= len(row) {
+			continue
+		}
+		if len(strings.TrimSpace(row[f.ReadTags.index])) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// appendDetailRow decodes a row of a master-detail sheet.
+// The row is added to the children of its parent, the parent being created
+// the first time its key is found. A row without parent values belongs
+// to the parent of the previous row (ie: merged parent cells).
+func (r *StructReader) appendDetailRow(slice reflect.Value, parents map[string]int, row []string, rowNum int) (reflect.Value, error) {
+	key := r.parentKey(row)
+	index, ok := parents[key]
+	if !ok && len(key) == 0 && slice.Len() > 0 {
+		index, ok = slice.Len()-1, true
+	}
+	if !ok {
+		value, err := r.unmarshallRow(row, rowNum)
+		if err != nil {
+			return slice, err
+		}
+		slice = reflect.Append(slice, value)
+		index = slice.Len() - 1
+		parents[key] = index
+	}
+
+	if r.isChildEmpty(row) {
+		return slice, nil
+	}
+
+	child, err := r.children.unmarshallRow(row, rowNum)
+	if err != nil {
+		return slice, err
+	}
+	children, err := r.container.findFieldByIndex(slice.Index(index), r.childrenField.Index)
+	if err != nil {
+		return slice, err
+	}
+	children.Set(reflect.Append(children, child))
+	return slice, nil
+}
+
+// newChildrenWriter creates the writer of the detail rows of a master-detail struct
+func (w *StructWriter) newChildrenWriter() error {
+	f, err := w.Struct.childrenField(false)
+	if err != nil || f == nil {
+		return err
+	}
+	children, err := newStructWriter(w.Writer, reflect.New(f.Type))
+	if err != nil {
+		return fmt.Errorf("excel: failed to create writer of children field '%s': %w", f.Name, err)
+	}
+	w.children = children
+	w.childrenField = f
+	return nil
+}
+
+// updateChildrenColumnIndex sets the columns of the children fields.
+// Columns which are not found in the title row are written after the parent columns.
+func (w *StructWriter) updateChildrenColumnIndex(row []string) {
+	next := 0
+	for _, f := range w.Struct.Fields {
+		if f != nil && !f.GetWriteIgnore() && f.WriteTags.index >= next {
+			next = f.WriteTags.index + 1
+		}
+	}
+	for _, f := range w.children.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+		for colIndex, cell := range row {
+			if f.GetWriteColumnName() == cell {
+				f.WriteTags.index = colIndex
+				break
+			}
+		}
+		if f.WriteTags.index >= next {
+			next = f.WriteTags.index + 1
+		}
+	}
+	for _, f := range w.children.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() || f.WriteTags.index >= 0 {
+			continue
+		}
+		f.WriteTags.index = next
+		next++
+	}
+}
+
+// writeElement writes an element and returns the number of written rows.
+// The element of a master-detail struct is written on the rows of its children.
+func (w *StructWriter) writeElement(values reflect.Value, col, row int) (int, error) {
+	if w.children == nil {
+		return 1, w.writeFields(values, col, row)
+	}
+
+	children, err := w.container.findFieldByIndex(values, w.childrenField.Index)
+	if err != nil {
+		return 0, fmt.Errorf("excel: failed to find field at index %d: %w", w.childrenField.Index, err)
+	}
+	if children.Len() == 0 {
+		return 1, w.writeFields(values, col, row)
+	}
+
+	merge := w.Writer.opts.mergeParents && children.Len() > 1
+	for i := 0; i < children.Len(); i++ {
+		if i == 0 || !merge {
+			if err := w.writeFields(values, col, row+i); err != nil {
+				return 0, err
+			}
+		}
+
+		child := children.Index(i)
+		if child.Kind() == reflect.Pointer {
+			if child.IsNil() {
+				continue
+			}
+			child = child.Elem()
+		}
+		if err := w.children.writeFields(child, col, row+i); err != nil {
+			return 0, err
+		}
+	}
+
+	if merge {
+		if err := w.mergeParentCells(col, row, row+children.Len()-1); err != nil {
+			return 0, err
+		}
+	}
+	return children.Len(), nil
+}
+
+// mergeParentCells merges the cells of the parent fields between two rows
+func (w *StructWriter) mergeParentCells(col, firstRow, lastRow int) error {
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+		top, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, firstRow)
+		if err != nil {
+			return err
+		}
+		bottom, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, lastRow)
+		if err != nil {
+			return err
+		}
+		if err := w.Writer.file.MergeCell(w.Writer.Sheet.Name, top, bottom); err != nil {
+			return fmt.Errorf("excel: failed to merge cells %s:%s: %w", top, bottom, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package excel
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// WithMergedValues reads the value of a merged cell in every row and column covered by the merge.
+// Without this option, only the top-left cell of a merged range holds the value.
+func WithMergedValues() Option {
+	return func(o *options) {
+		o.mergedValues = true
+	}
+}
+
+// WithMergeColumns merges the consecutive data cells having the same value
+// in the columns with the given titles when writing: the columns of the struct fields,
+// or of the map keys. Slices of slices have no titles and can't be written with this option.
+func WithMergeColumns(columns ...string) Option {
+	return func(o *options) {
+		o.mergeColumns = columns
+	}
+}
+
+// loadMergedValues returns the values of the cells covered by the merged cells of the sheet.
+// The values are indexed by row number then by column number.
+func (r *Reader) loadMergedValues() (map[int]map[int]string, error) {
+	cells, err := r.file.GetMergeCells(r.Sheet.Name)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[int]map[int]string)
+	for _, cell := range cells {
+		rng, err := ToRange(cell.GetStartAxis() + ":" + cell.GetEndAxis())
+		if err != nil {
+			return nil, err
+		}
+		for row := rng.StartRow; row <= rng.EndRow; row++ {
+			if values[row] == nil {
+				values[row] = make(map[int]string)
+			}
+			for col := rng.StartColumn; col <= rng.EndColumn; col++ {
+				values[row][col] = cell.GetCellValue()
+			}
+		}
+	}
+	return values, nil
+}
+
+// fillMergedValues sets the values of the merged cells in a row
+func fillMergedValues(row []string, values map[int]string) []string {
+	for col, value := range values {
+		for len(row) < col {
+			row = append(row, "")
+		}
+		row[col-1] = value
+	}
+	return row
+}
+
+// trackMerge records the value of a data cell written in a column given to WithMergeColumns
+func (w *Writer) trackMerge(col, row int, value string) {
+	r := &w.region
+	if r.merged == nil {
+		r.merged = make(map[int]map[int]string)
+	}
+	if r.merged[col] == nil {
+		r.merged[col] = make(map[int]string)
+	}
+	r.merged[col][row] = value
+}
+
+// mergeColumns merges the consecutive data cells having the same value
+// in the columns given to WithMergeColumns
+func (w *Writer) mergeColumns() error {
+	for col, values := range w.region.merged {
+		rows := slices.Sorted(maps.Keys(values))
+
+		// Merge the groups of identical values in consecutive rows
+		first := 0
+		for i, row := range rows {
+			if i+1 < len(rows) && rows[i+1] == row+1 && values[rows[i+1]] == values[row] {
+				continue
+			}
+			if row > rows[first] && len(strings.TrimSpace(values[row])) > 0 {
+				top, _ := excelize.CoordinatesToCellName(col, rows[first])
+				bottom, _ := excelize.CoordinatesToCellName(col, row)
+				if err := w.file.MergeCell(w.Sheet.Name, top, bottom); err != nil {
+					return fmt.Errorf("excel: failed to merge cells %s:%s: %w", top, bottom, err)
+				}
+			}
+			first = i + 1
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package excel
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Metadata filled by the meta tag when reading
+const (
+	MetaRow   = "row"   // the 1-based row number in the sheet
+	MetaSheet = "sheet" // the name of the sheet the row is read from
+	MetaCell  = "cell"  // the reference of the cell of a column (ie: meta:cell:Price)
+	MetaRaw   = "raw"   // the raw values of the row
+)
+
+// readMeta fills the fields tagged with meta.
+// rowNum is the 1-based row number in the sheet.
+func (r *StructReader) readMeta(container reflect.Value, row []string, rowNum int) error {
+	for _, f := range r.Struct.Fields {
+		if f == nil {
+			continue
+		}
+		meta := f.GetReadMeta()
+		if len(meta) == 0 {
+			continue
+		}
+
+		var value any
+		switch kind, column, _ := strings.Cut(meta, ":"); kind {
+		case MetaRow:
+			value = rowNum
+		case MetaSheet:
+			value = r.Reader.Sheet.Name
+		case MetaCell:
+			cell, err := r.cellOf(column, rowNum)
+			if err != nil {
+				return fmt.Errorf("excel: failed to get cell of column '%s': %w", column, err)
+			}
+			value = cell
+		case MetaRaw:
+			value = append([]string(nil), row...)
+		default:
+			return fmt.Errorf("excel: unknown meta '%s' of field '%s'", meta, f.Name)
+		}
+
+		v := reflect.ValueOf(value)
+		if !v.Type().ConvertibleTo(f.Type) || (f.Type.Kind() == reflect.String && v.Kind() != reflect.String) {
+			return fmt.Errorf("excel: meta field '%s' can't hold a %v", f.Name, v.Type())
+		}
+		if err := r.container.assign(container, f.Index, v.Convert(f.Type)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cellOf returns the reference of the cell of a column in a row.
+// An empty reference is returned if the column is not in the title row.
+func (r *StructReader) cellOf(column string, rowNum int) (string, error) {
+	for index, title := range r.titles {
+		if title == column {
+			return excelize.CoordinatesToCellName(r.Reader.Axis.Col+index, rowNum)
+		}
+	}
+	return "", nil
+}
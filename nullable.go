@@ -0,0 +1,101 @@
+package excel
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Optional is a value which may be missing.
+// When reading, an empty cell gives an invalid value and a non-empty cell
+// is decoded into the value with all the tags of the field.
+// When writing, an invalid value gives an empty cell or the default of the field.
+// The sql.Null types (sql.NullString, sql.NullInt64, sql.NullTime, sql.Null[T]...)
+// are handled the same way. Fields with an encoding tag are encoded as a whole.
+//
+// Example:
+//
+//	type Customer struct {
+//		Name  string                 `excel:"Name"`
+//		Email sql.NullString         `excel:"Email"`
+//		Score excel.Optional[float64] `excel:"Score"`
+//	}
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// optionalPkgPath is the package path of the Optional type
+var optionalPkgPath = reflect.TypeOf(Optional[int]{}).PkgPath()
+
+// isNullableType returns true if the type is an Optional or a sql.Null type
+func isNullableType(t reflect.Type) bool {
+	switch t.PkgPath() {
+	case optionalPkgPath:
+		return strings.HasPrefix(t.Name(), "Optional[")
+	case "database/sql":
+		return strings.HasPrefix(t.Name(), "Null")
+	}
+	return false
+}
+
+// nullableValue returns the index of the value of a nullable struct.
+// A nullable struct has two fields: the value and a Valid boolean.
+func nullableValue(t reflect.Type) (int, bool) {
+	if t.Kind() != reflect.Struct || t.NumField() != 2 || !isNullableType(t) {
+		return 0, false
+	}
+	valid, ok := t.FieldByName("Valid")
+	if !ok || valid.Type.Kind() != reflect.Bool || len(valid.Index) != 1 {
+		return 0, false
+	}
+	index := 1 - valid.Index[0]
+	if !t.Field(index).IsExported() {
+		return 0, false
+	}
+	return index, true
+}
+
+// isNullable returns true if the field holds an Optional or a sql.Null value
+// and has no encoding when reading or writing
+func (f *Field) isNullable(read bool) bool {
+	encoding := f.GetWriteEncoding()
+	if read {
+		encoding = f.GetReadEncoding()
+	}
+	if len(encoding) > 0 {
+		return false
+	}
+	_, ok := nullableValue(indirectType(f.Type))
+	return ok
+}
+
+// readNullable reads the value of a nullable field.
+// The value is valid if the cell is not empty and can be decoded.
+func (r *StructReader) readNullable(f *Field, row []string, rowNum int, cell string) (reflect.Value, error) {
+	t := indirectType(f.Type)
+	index, _ := nullableValue(t)
+	nullable := reflect.New(t).Elem()
+
+	if len(cell) > 0 {
+		inner := t.Field(index).Type
+		value, err := r.readField(f.withType(inner), row, rowNum)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if value.IsValid() && value.Type().ConvertibleTo(inner) {
+			nullable.Field(index).Set(value.Convert(inner))
+			nullable.FieldByName("Valid").SetBool(true)
+		}
+	}
+
+	return pointerTo(nullable, f.Type), nil
+}
+
+// nullable returns the value of a nullable value and whether it is valid
+func nullable(value reflect.Value) (reflect.Value, bool) {
+	if value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	index, _ := nullableValue(value.Type())
+	return value.Field(index), value.FieldByName("Valid").Bool()
+}
@@ -0,0 +1,222 @@
+package excel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Named number formats of the numfmt tag.
+// Other values are used as custom number formats (ie: numfmt:0.0%).
+const (
+	NumFmtPercent    = "percent"    // 0.00%
+	NumFmtInteger    = "integer"    // #,##0
+	NumFmtNumber     = "number"     // #,##0.00
+	NumFmtAccounting = "accounting" // negative numbers in parentheses
+)
+
+// builtInNumFmts are the excel built-in number formats of the named formats
+var builtInNumFmts = map[string]int{
+	NumFmtPercent: 10,
+	NumFmtInteger: 3,
+	NumFmtNumber:  4,
+}
+
+// customNumFmts are the custom number formats of the named formats
+var customNumFmts = map[string]string{
+	NumFmtAccounting: `#,##0.00_);(#,##0.00)`,
+}
+
+// currency is the way the amounts of a currency are written
+type currency struct {
+	symbol   string
+	suffix   bool
+	decimals int
+}
+
+// currencies are the currencies of the currency tag, by ISO 4217 code
+var currencies = map[string]currency{
+	"EUR": {symbol: "€", suffix: true, decimals: 2},
+	"USD": {symbol: "$", decimals: 2},
+	"GBP": {symbol: "£", decimals: 2},
+	"JPY": {symbol: "¥"},
+	"CNY": {symbol: "¥", decimals: 2},
+	"CHF": {symbol: "CHF", suffix: true, decimals: 2},
+}
+
+// currencyNumFmt returns the number format of a currency (ie: EUR)
+func currencyNumFmt(code string) string {
+	c, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		c = currency{symbol: code, suffix: true, decimals: 2}
+	}
+	format := "#,##0"
+	if c.decimals > 0 {
+		format += "." + strings.Repeat("0", c.decimals)
+	}
+	if c.suffix {
+		return format + ` "` + c.symbol + `"`
+	}
+	return `"` + c.symbol + `"` + format
+}
+
+// numFmtStyle returns the style applying the numfmt or the currency tag of a field.
+// It returns false if the field has no number format.
+func (w *StructWriter) numFmtStyle(f *Field) (int, bool, error) {
+	style := &excelize.Style{}
+	var key string
+	switch numFmt, code := f.GetWriteNumFmt(), f.GetWriteCurrency(); {
+	case len(code) > 0:
+		key = "currency:" + code
+		format := currencyNumFmt(code)
+		style.CustomNumFmt = &format
+	case len(numFmt) > 0:
+		key = "numfmt:" + numFmt
+		if id, ok := builtInNumFmts[strings.ToLower(numFmt)]; ok {
+			style.NumFmt = id
+		} else if format, ok := customNumFmts[strings.ToLower(numFmt)]; ok {
+			style.CustomNumFmt = &format
+		} else {
+			style.CustomNumFmt = &numFmt
+		}
+	case len(f.defaultNumFmt()) > 0:
+		format := f.defaultNumFmt()
+		key = "numfmt:" + format
+		style.CustomNumFmt = &format
+	default:
+		return 0, false, nil
+	}
+
+	if id, ok := w.Writer.styles[key]; ok {
+		return id, true, nil
+	}
+	id, err := w.Writer.file.NewStyle(style)
+	if err != nil {
+		return 0, false, fmt.Errorf("excel: failed to create the number format of field '%s': %w", f.Name, err)
+	}
+	if w.Writer.styles == nil {
+		w.Writer.styles = make(map[string]int)
+	}
+	w.Writer.styles[key] = id
+	return id, true, nil
+}
+
+// writeNumFmt applies the number format of a field to a cell
+func (w *StructWriter) writeNumFmt(f *Field, cell string) error {
+	style, ok, err := w.numFmtStyle(f)
+	if err != nil || !ok {
+		return err
+	}
+	return w.Writer.file.SetCellStyle(w.Writer.Sheet.Name, cell, cell, style)
+}
+
+// isFormattedNumber returns true if the numbers of the field typed as formatted text are parsed
+func (f *Field) isFormattedNumber() bool {
+	return len(f.GetReadNumFmt()) > 0 || len(f.GetReadCurrency()) > 0
+}
+
+// parseNumber parses a formatted number with the separators of a locale.
+// Currency symbols and ISO 4217 codes are removed, numbers in parentheses are negative
+// and percents are divided by 100 (ie: "($1,200.50)", "12 %", "1 234,56 €", "EUR 3").
+func parseNumber(from string, l numberLocale) (float64, error) {
+	s := strings.TrimSpace(from)
+
+	// Accounting negative numbers
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative, s = true, s[1:len(s)-1]
+	}
+
+	// Percent
+	percent := strings.HasSuffix(s, "%")
+	s = strings.TrimSuffix(s, "%")
+
+	// Currency symbols and codes, and signs before or after them
+	s = trimCurrency(s)
+	if strings.HasPrefix(s, "-") {
+		negative, s = !negative, trimCurrency(s[1:])
+	} else if strings.HasSuffix(s, "-") {
+		negative, s = !negative, trimCurrency(s[:len(s)-1])
+	}
+
+	if !hasValidGroups(s, l) {
+		return 0, fmt.Errorf("excel: failed to parse number '%s': invalid group separators", from)
+	}
+	n, err := strconv.ParseFloat(normalizeNumber(s, l), 64)
+	if err != nil {
+		return 0, fmt.Errorf("excel: failed to parse number '%s': %w", from, err)
+	}
+	if percent {
+		n /= 100
+	}
+	if negative {
+		n = -n
+	}
+	return n, nil
+}
+
+// trimCurrency removes a currency symbol (ie: €) or an ISO 4217 code (ie: EUR)
+// before or after a number, and the spaces around the number
+func trimCurrency(s string) string {
+	s = strings.TrimFunc(s, unicode.IsSpace)
+	if r, size := utf8.DecodeRuneInString(s); unicode.Is(unicode.Sc, r) {
+		s = s[size:]
+	} else if len(s) >= 3 && isCurrencyCode(s[:3]) && !startsWithLetter(s[3:]) {
+		s = s[3:]
+	}
+	if r, size := utf8.DecodeLastRuneInString(s); unicode.Is(unicode.Sc, r) {
+		s = s[:len(s)-size]
+	} else if len(s) >= 3 && isCurrencyCode(s[len(s)-3:]) && !endsWithLetter(s[:len(s)-3]) {
+		s = s[:len(s)-3]
+	}
+	return strings.TrimFunc(s, unicode.IsSpace)
+}
+
+// isCurrencyCode returns true if s has the form of an ISO 4217 code: three capital letters
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// startsWithLetter returns true if the first rune of s is a letter
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+// endsWithLetter returns true if the last rune of s is a letter
+func endsWithLetter(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+// hasValidGroups returns true if the group separators of a number are only used
+// before the decimal separator, between groups of three digits
+func hasValidGroups(s string, l numberLocale) bool {
+	group := strings.TrimSpace(l.group)
+	if len(group) == 0 || !strings.Contains(s, group) {
+		return true
+	}
+	integer, decimals, _ := strings.Cut(s, l.decimal)
+	if strings.Contains(decimals, group) {
+		return false
+	}
+	parts := strings.Split(integer, group)
+	for _, part := range parts[1:] {
+		if len(part) != 3 {
+			return false
+		}
+	}
+	return len(strings.TrimLeft(parts[0], "+-")) > 0
+}
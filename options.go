@@ -0,0 +1,188 @@
+package excel
+
+import "context"
+
+// Option configures the behaviour of a Reader or a Writer.
+// Options are given to NewReader, NewWriter or Excel.SetOptions.
+// Options that only make sense for reading are ignored by writers and vice versa.
+type Option func(o *options)
+
+// ProgressFunc is called after each data row has been read or written.
+// rowsDone is the number of data rows processed so far.
+type ProgressFunc func(rowsDone int)
+
+// RowFilterFunc is called with the raw values of each data row when reading,
+// and with the encoded values of each element, by column, when writing.
+// The row is skipped when it returns false.
+type RowFilterFunc func(raw []string) bool
+
+// RowTransformFunc is called with the raw values of each data row when reading,
+// and with the encoded values of each element, by column, when writing.
+// The returned values are decoded, or written, instead of the raw ones.
+type RowTransformFunc func(raw []string) []string
+
+// options holds the settings shared by readers and writers
+type options struct {
+	// ctx is the context of the running operation
+	ctx context.Context
+	// progress is called after each processed row
+	progress ProgressFunc
+
+	// rowFilter is called to skip data rows
+	rowFilter RowFilterFunc
+	// rowTransform is called to change data rows
+	rowTransform RowTransformFunc
+	// skipRows is called to skip data rows when reading
+	skipRows RowFilterFunc
+	// stopAtEmptyRows is the number of consecutive empty rows which ends the reading
+	stopAtEmptyRows int
+	// maxRows is the maximum number of data rows to read
+	maxRows int
+	// endMarker is the value of a cell which ends the reading
+	endMarker string
+
+	// freezeHeader freezes the title row when writing
+	freezeHeader bool
+	// autoFilter adds an autofilter over the written data
+	autoFilter bool
+	// autoFit sets the width of the written columns from their values
+	autoFit bool
+	// maxWidth is the maximum width of the auto fitted columns
+	maxWidth float64
+	// subtotalKey is the name of the field grouping the subtotal rows
+	subtotalKey string
+	// collapseOutlines hides the rows written with an outline level
+	collapseOutlines bool
+	// outlineLevels reads the outline level of the data rows
+	outlineLevels bool
+	// mergeParents merges the parent cells of master-detail rows when writing
+	mergeParents bool
+	// mergedValues reads the value of the merged cells in all the covered cells
+	mergedValues bool
+	// skipHiddenRows skips the hidden data rows when reading
+	skipHiddenRows bool
+	// skipHiddenColumns skips the hidden columns when reading
+	skipHiddenColumns bool
+	// mergeColumns are the titles of the columns whose identical values are merged when writing
+	mergeColumns []string
+	// strict checks the title row before reading
+	strict bool
+	// cellErrors reports the values which can't be converted to their field
+	cellErrors bool
+	// locale is the locale of the numbers
+	locale string
+}
+
+// WithProgress sets a callback which is called after each data row
+// has been read or written.
+func WithProgress(fn ProgressFunc) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithRowFilter sets a filter called with the raw values of each data row when reading,
+// and with the encoded values of each element when writing.
+// Rows for which the filter returns false are skipped.
+func WithRowFilter(fn RowFilterFunc) Option {
+	return func(o *options) {
+		o.rowFilter = fn
+	}
+}
+
+// WithRowTransform sets a function called with the raw values of each data row when reading,
+// and with the encoded values of each element when writing.
+// The function is called after the row filter. When reading, its result is decoded.
+// When writing, the changed values are decoded and written with the type of their cell.
+func WithRowTransform(fn RowTransformFunc) Option {
+	return func(o *options) {
+		o.rowTransform = fn
+	}
+}
+
+// WithSkipRows sets a predicate called with the raw values of each data row when reading.
+// Rows for which the predicate returns true are skipped.
+func WithSkipRows(fn RowFilterFunc) Option {
+	return func(o *options) {
+		o.skipRows = fn
+	}
+}
+
+// WithStopAtEmptyRows stops the reading after n consecutive empty rows.
+// A row is empty when all its cells are blank. Empty rows are never decoded.
+// With n equal to 1, the reading stops at the first empty row.
+func WithStopAtEmptyRows(n int) Option {
+	return func(o *options) {
+		o.stopAtEmptyRows = n
+	}
+}
+
+// WithMaxRows limits the number of data rows read.
+// Title rows are not counted. A limit of 0 means no limit.
+func WithMaxRows(n int) Option {
+	return func(o *options) {
+		o.maxRows = n
+	}
+}
+
+// WithEndMarker stops the reading at the first row having a cell equal to the marker.
+// The row containing the marker is not read.
+func WithEndMarker(marker string) Option {
+	return func(o *options) {
+		o.endMarker = marker
+	}
+}
+
+// SetOptions applies the options to the reader or writer
+func (e *Excel) SetOptions(opts ...Option) {
+	if e.Reader != nil {
+		e.Reader.opts.apply(opts...)
+	}
+	if e.Writer != nil {
+		e.Writer.opts.apply(opts...)
+	}
+}
+
+// apply applies all options
+func (o *options) apply(opts ...Option) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+}
+
+// done returns the context error if the running operation has been cancelled
+func (o *options) done() error {
+	if o.ctx == nil {
+		return nil
+	}
+	select {
+	case <-o.ctx.Done():
+		return o.ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// notify reports the number of processed rows to the progress callback
+func (o *options) notify(rowsDone int) {
+	if o.progress != nil {
+		o.progress(rowsDone)
+	}
+}
+
+// prepareRow applies the row filters and the row transformation to a data row.
+// It returns false if the row must be skipped.
+func (o *options) prepareRow(row []string) ([]string, bool) {
+	if o.skipRows != nil && o.skipRows(row) {
+		return nil, false
+	}
+	if o.rowFilter != nil && !o.rowFilter(row) {
+		return nil, false
+	}
+	if o.rowTransform != nil {
+		row = o.rowTransform(row)
+	}
+	return row, true
+}
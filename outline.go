@@ -0,0 +1,177 @@
+package excel
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// OutlineLeveler can be implemented by the element type of the container.
+// OutlineLevel returns the outline level of the row written for the element,
+// 0 meaning that the row doesn't belong to a group.
+// Rows are grouped by Excel from their outline levels: an order written at
+// level 0 followed by its lines written at level 1 can be collapsed.
+type OutlineLeveler interface {
+	OutlineLevel() int
+}
+
+// OutlineLevelSetter can be implemented by the element type of the container.
+// When the WithOutlineLevels option is used, SetOutlineLevel is called with
+// the outline level of the row read into the element.
+type OutlineLevelSetter interface {
+	SetOutlineLevel(level int)
+}
+
+// outlineRow is a row written with its outline level
+type outlineRow struct {
+	row   int
+	level int
+}
+
+// WithCollapsedOutlines hides the rows written with an outline level
+// and marks the summary rows of the groups as collapsed,
+// so that the groups are collapsed when the file is opened.
+func WithCollapsedOutlines() Option {
+	return func(o *options) {
+		o.collapseOutlines = true
+	}
+}
+
+// WithOutlineLevels reads the outline level of each data row.
+// The levels are given to the elements implementing OutlineLevelSetter
+// and are returned in ReaderResult.OutlineLevels.
+func WithOutlineLevels() Option {
+	return func(o *options) {
+		o.outlineLevels = true
+	}
+}
+
+// writeOutlineLevel sets the outline level of a written row
+// when the element implements OutlineLeveler
+func (w *Writer) writeOutlineLevel(value reflect.Value, row int) error {
+	leveler, ok := hookTarget(value).(OutlineLeveler)
+	if !ok {
+		return nil
+	}
+	level := max(leveler.OutlineLevel(), 0)
+	if level > 7 {
+		return fmt.Errorf("excel: outline level %d of row %d is greater than 7", level, row)
+	}
+
+	// The previous row is the summary row of the group starting at this row
+	previous := w.outline
+	w.outline = outlineRow{row: row, level: level}
+	if level == 0 {
+		return nil
+	}
+
+	// The summary row of a group is written before its detail rows
+	if !w.outlined {
+		below := false
+		if err := w.file.SetSheetProps(w.Sheet.Name, &excelize.SheetPropsOptions{OutlineSummaryBelow: &below}); err != nil {
+			return err
+		}
+		w.outlined = true
+	}
+
+	if err := w.file.SetRowOutlineLevel(w.Sheet.Name, row, uint8(level)); err != nil {
+		return err
+	}
+	if w.opts.collapseOutlines {
+		if previous.row == row-1 && previous.row > 0 && previous.level < level {
+			w.setRowCollapsed(previous.row)
+		}
+		return w.file.SetRowVisible(w.Sheet.Name, row, false)
+	}
+	return nil
+}
+
+// setRowCollapsed sets the collapsed attribute of a summary row.
+// excelize has no function for it: the row is updated in the worksheet loaded by excelize,
+// found by reflection. Nothing is done if the worksheet doesn't have the expected fields;
+// the detail rows of the group are hidden anyway.
+func (w *Writer) setRowCollapsed(row int) {
+	// field returns the field of a struct, or of a pointer to a struct, having the given kind
+	field := func(v reflect.Value, name string, kind reflect.Kind) (reflect.Value, bool) {
+		v = reflect.Indirect(v)
+		if v.Kind() == reflect.Interface {
+			v = reflect.Indirect(v.Elem())
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		f := v.FieldByName(name)
+		return f, f.IsValid() && f.Kind() == kind
+	}
+
+	// Relationship id of the sheet in the workbook
+	var id string
+	for _, sheet := range w.file.WorkBook.Sheets.Sheet {
+		if sheet.Name == w.Sheet.Name {
+			id = sheet.ID
+		}
+	}
+
+	// The worksheet path is the target of the relationship of the sheet
+	var ws any
+	w.file.Relationships.Range(func(key, value any) bool {
+		rels, ok := key.(string)
+		if !ok || !strings.HasSuffix(rels, "workbook.xml.rels") {
+			return true
+		}
+		list, ok := field(reflect.ValueOf(value), "Relationships", reflect.Slice)
+		for i := 0; ok && i < list.Len(); i++ {
+			relID, okID := field(list.Index(i), "ID", reflect.String)
+			target, okTarget := field(list.Index(i), "Target", reflect.String)
+			if !okID || !okTarget || relID.String() != id {
+				continue
+			}
+			name := target.String()
+			if !strings.HasPrefix(name, "/") {
+				name = path.Join(path.Dir(path.Dir(rels)), name)
+			}
+			ws, _ = w.file.Sheet.Load(strings.TrimPrefix(name, "/"))
+			break
+		}
+		return false
+	})
+	if ws == nil {
+		return
+	}
+
+	// Row of the worksheet
+	data, ok := field(reflect.ValueOf(ws), "SheetData", reflect.Struct)
+	if !ok {
+		return
+	}
+	rows, ok := field(data, "Row", reflect.Slice)
+	for i := 0; ok && i < rows.Len(); i++ {
+		r, okRow := field(rows.Index(i), "R", reflect.Int)
+		collapsed, okCollapsed := field(rows.Index(i), "Collapsed", reflect.Bool)
+		if okRow && okCollapsed && r.Int() == int64(row) && collapsed.CanSet() {
+			collapsed.SetBool(true)
+			return
+		}
+	}
+}
+
+// readOutlineLevel returns the outline level of a row
+func (r *Reader) readOutlineLevel(row int) (int, error) {
+	level, err := r.file.GetRowOutlineLevel(r.Sheet.Name, row)
+	return int(level), err
+}
+
+// setOutlineLevel records the outline level of a read row in the result
+// and gives it to the element when it implements OutlineLevelSetter
+func (r *Reader) setOutlineLevel(result *ReaderResult, value reflect.Value, level int) {
+	if !r.opts.outlineLevels {
+		return
+	}
+	result.OutlineLevels = append(result.OutlineLevels, level)
+	if setter, ok := hookTarget(value).(OutlineLevelSetter); ok {
+		setter.SetOutlineLevel(level)
+	}
+}
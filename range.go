@@ -53,6 +53,32 @@ func ToRange(ref string) (*Range, error) {
 	return r, nil
 }
 
+// SetRange sets the range to be used by the reader or writer.
+// The reader only reads the cells inside the range, the first row being the title row.
+// The writer starts writing at the first cell of the range.
+// Setting an axis afterward removes the range.
+func (e *Excel) SetRange(ref string) error {
+	rng, err := ToRange(ref)
+	if err != nil {
+		return err
+	}
+	if rng.EndColumn < rng.StartColumn || rng.EndRow < rng.StartRow {
+		return ErrRangeNotValid
+	}
+	if e.Reader != nil {
+		e.Reader.setRange(rng)
+	}
+	if e.Writer != nil {
+		e.Writer.setAxisCoordinates(rng.StartColumn, rng.StartRow)
+	}
+	return nil
+}
+
+func (r *Reader) setRange(rng *Range) {
+	r.setAxisCoordinates(rng.StartColumn, rng.StartRow)
+	r.Range = rng
+}
+
 // MinRange returns the minimum range
 func MinRange(startName string) (*Range, error) {
 	return ToRange(fmt.Sprintf("%s:%s", startName, startName))
@@ -25,7 +25,10 @@ type Reader struct {
 	file   *excelize.File
 	Sheet  Sheet
 	Axis   Axis
+	Range  *Range
 	Result *ReaderResult
+
+	opts options
 }
 
 // ReaderResult contains information about the result of a read operation,
@@ -33,6 +36,13 @@ type Reader struct {
 type ReaderResult struct {
 	Rows    int
 	Columns int
+
+	// OutlineLevels are the outline levels of the data rows read,
+	// filled when the WithOutlineLevels option is used
+	OutlineLevels []int
+
+	// Sheets are the results of each sheet read by UnmarshalAllSheets
+	Sheets []SheetResult
 }
 
 // validate validates the reader configuration.
@@ -39,7 +39,7 @@ func newMapReader(reader *Reader, value reflect.Value) (*mapReader, error) {
 
 func (r *mapReader) Unmarshall() (*ReaderResult, error) {
 	// get excel rows
-	rows, startCol, err := r.Reader.getRows()
+	it, err := r.Reader.newRowIterator(1)
 	if err != nil {
 		return nil, err
 	}
@@ -51,55 +51,47 @@ func (r *mapReader) Unmarshall() (*ReaderResult, error) {
 	result := &ReaderResult{}
 
 	// Loop throw all rows
-	rowIndex := 0
-	for rows.Next() {
-		row, err := rows.Columns()
-		if err != nil {
-			break
-		}
-		if row == nil {
-			break
-		}
-
-		// Apply column offset if needed
-		if startCol > 0 && len(row) > startCol {
-			row = row[startCol:]
-		} else if startCol > 0 && len(row) <= startCol {
-			// Skip this row if it doesn't have enough columns
-			rowIndex++
-			continue
-		}
+	for it.Next() {
+		row := it.Row()
 
 		// Title row
-		if rowIndex == 0 {
+		if it.IsHeader() {
 			err := r.getColumns(row)
 			if err != nil {
+				_ = it.Close()
 				return nil, err
 			}
+			continue
 		}
 
 		// Data row
-		if rowIndex > 0 {
-			value, err := r.unmarshallRow(row)
-			if err != nil {
-				return nil, err
-			}
+		value, err := r.unmarshallRow(row)
+		if err != nil {
+			_ = it.Close()
+			return nil, err
+		}
 
-			if value.IsValid() {
-				slice = reflect.Append(slice, value)
-			}
+		if value.IsValid() {
+			r.Reader.setOutlineLevel(result, value, it.Level())
+			slice = reflect.Append(slice, value)
 		}
-		rowIndex++
+
+		// Report the progress
+		r.Reader.opts.notify(slice.Len())
+	}
+	if err := it.Err(); err != nil {
+		_ = it.Close()
+		return nil, err
 	}
 
 	// Set the result
-	result.Rows = rowIndex
+	result.Rows = it.Count()
 	result.Columns = len(r.Columns)
 
 	// Set the slice to the container
 	r.container.Value.Elem().Set(slice)
 
-	return result, rows.Close()
+	return result, it.Close()
 }
 
 func (r *mapReader) SetColumnsTags(_ map[string]*Tags) {
@@ -135,6 +127,11 @@ func (r *mapReader) unmarshallRow(row []string) (reflect.Value, error) {
 
 	// loop throw all cells of the row
 	for index, cell := range row {
+		// Ignore the cells without title
+		if index >= len(r.Columns) {
+			break
+		}
+
 		sCell := convert.ToString(cell)
 		value, err := convert.ToValueE(sCell, containerValueType)
 		if err != nil {
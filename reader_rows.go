@@ -0,0 +1,262 @@
+package excel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// rowIterator iterates over the rows of the sheet read by a Reader.
+// It applies the column offset of the axis, the bounds of the range and all the
+// reader options (cancellation, row filters, empty rows, end marker and rows limit)
+// so that the struct, map and slice readers behave the same way.
+type rowIterator struct {
+	reader   *Reader
+	rows     *excelize.Rows
+	startCol int
+
+	// headers is the number of title rows to return before the data rows
+	headers int
+	// count is the number of returned rows, title rows included
+	count int
+	// dataRows is the number of returned data rows
+	dataRows int
+	// emptyRows is the number of consecutive empty data rows
+	emptyRows int
+
+	// merged are the values of the merged cells by row and column number
+	merged map[int]map[int]string
+	// hiddenColumns caches the visibility of the columns by column number
+	hiddenColumns map[int]bool
+
+	rowNum int
+	row    []string
+	level  int
+	header bool
+	err    error
+	done   bool
+}
+
+// newRowIterator creates an iterator over the rows of the reader sheet.
+// headers is the number of title rows expected at the axis row.
+func (r *Reader) newRowIterator(headers int) (*rowIterator, error) {
+	rows, startCol, err := r.getRows()
+	if err != nil {
+		return nil, err
+	}
+	it := &rowIterator{
+		reader:   r,
+		rows:     rows,
+		startCol: startCol,
+		headers:  headers,
+		rowNum:   r.Axis.Row - 1,
+	}
+	if !r.isAxisValid() {
+		it.rowNum = 0
+	}
+	if r.opts.skipHiddenColumns {
+		it.hiddenColumns = make(map[int]bool)
+	}
+	if r.opts.mergedValues {
+		if it.merged, err = r.loadMergedValues(); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+	}
+	return it, nil
+}
+
+// Next moves the iterator to the next row to decode.
+// It returns false when there are no more rows or when an error occurred.
+func (it *rowIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	opts := &it.reader.opts
+
+	for {
+		// Stop if the operation has been cancelled
+		if it.err = opts.done(); it.err != nil {
+			return it.stop()
+		}
+
+		// Stop when the rows limit is reached
+		if opts.maxRows > 0 && it.headers == 0 && it.dataRows >= opts.maxRows {
+			return it.stop()
+		}
+
+		if !it.rows.Next() {
+			return it.stop()
+		}
+		it.rowNum++
+
+		// Stop after the last row of the range
+		if rng := it.reader.Range; rng != nil && it.rowNum > rng.EndRow {
+			return it.stop()
+		}
+
+		raw, err := it.rows.Columns()
+		if err != nil {
+			it.err = fmt.Errorf("excel: failed to get columns for row %d: %w", it.rowNum, err)
+			return it.stop()
+		}
+
+		// Values of the merged cells
+		if values := it.merged[it.rowNum]; values != nil {
+			raw = fillMergedValues(raw, values)
+		}
+
+		// A row without any cell ends the reading
+		// unless empty rows are explicitly handled
+		if raw == nil && opts.stopAtEmptyRows == 0 {
+			return it.stop()
+		}
+
+		// Blank the hidden columns of the data rows, the titles are kept
+		if it.hiddenColumns != nil && raw != nil && it.headers == 0 {
+			if raw, it.err = it.reader.hideColumns(raw, it.hiddenColumns); it.err != nil {
+				return it.stop()
+			}
+		}
+
+		// Apply column offset if needed
+		row, short := it.offset(raw)
+
+		// Title row
+		if it.headers > 0 {
+			it.headers--
+			it.header = true
+			it.row = row
+			it.count++
+			return true
+		}
+
+		// Hidden rows
+		if opts.skipHiddenRows {
+			hidden, err := it.reader.isRowHidden(it.rowNum)
+			if err != nil {
+				it.err = err
+				return it.stop()
+			}
+			if hidden {
+				continue
+			}
+		}
+
+		// Empty rows
+		if isEmptyRow(row) && (short || opts.stopAtEmptyRows > 0) {
+			it.emptyRows++
+			if opts.stopAtEmptyRows > 0 && it.emptyRows >= opts.stopAtEmptyRows {
+				return it.stop()
+			}
+			continue
+		}
+		it.emptyRows = 0
+
+		// End marker
+		if len(opts.endMarker) > 0 && hasMarker(row, opts.endMarker) {
+			return it.stop()
+		}
+
+		// Skipped, filtered and transformed rows
+		row, keep := opts.prepareRow(row)
+		if !keep {
+			continue
+		}
+
+		// Outline level of the row
+		if opts.outlineLevels {
+			if it.level, it.err = it.reader.readOutlineLevel(it.rowNum); it.err != nil {
+				return it.stop()
+			}
+		}
+
+		it.header = false
+		it.row = row
+		it.count++
+		it.dataRows++
+		return true
+	}
+}
+
+// Row returns the values of the current row
+func (it *rowIterator) Row() []string {
+	return it.row
+}
+
+// RowNum returns the 1-based row number of the current row in the sheet
+func (it *rowIterator) RowNum() int {
+	return it.rowNum
+}
+
+// Level returns the outline level of the current row.
+// It is only read with the WithOutlineLevels option.
+func (it *rowIterator) Level() int {
+	return it.level
+}
+
+// IsHeader returns true if the current row is a title row
+func (it *rowIterator) IsHeader() bool {
+	return it.header
+}
+
+// Count returns the number of rows returned so far, title rows included
+func (it *rowIterator) Count() int {
+	return it.count
+}
+
+// Err returns the error which stopped the iteration
+func (it *rowIterator) Err() error {
+	return it.err
+}
+
+// Close closes the underlying rows reader
+func (it *rowIterator) Close() error {
+	return it.rows.Close()
+}
+
+// stop ends the iteration
+func (it *rowIterator) stop() bool {
+	it.done = true
+	it.row = nil
+	return false
+}
+
+// offset removes the columns located before the axis column
+// and after the last column of the range.
+// It returns true if the row doesn't reach the axis column.
+func (it *rowIterator) offset(row []string) ([]string, bool) {
+	if it.startCol > 0 {
+		if len(row) <= it.startCol {
+			return []string{}, true
+		}
+		row = row[it.startCol:]
+	} else if row == nil {
+		return row, true
+	}
+	if rng := it.reader.Range; rng != nil && len(row) > rng.Columns() {
+		row = row[:rng.Columns()]
+	}
+	return row, false
+}
+
+// isEmptyRow returns true if all the cells of the row are blank
+func isEmptyRow(row []string) bool {
+	for _, cell := range row {
+		if len(strings.TrimSpace(cell)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hasMarker returns true if one cell of the row is equal to the marker
+func hasMarker(row []string, marker string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) == marker {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package excel
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+)
+
+// SheetResult contains the result of the read of one sheet by UnmarshalAllSheets
+type SheetResult struct {
+	Sheet string
+	Rows  int
+	Err   error
+}
+
+// UnmarshalAllSheets reads every sheet whose name matches the filter and
+// appends their rows to the provided container.
+// The filter is a pattern as used by path.Match (ie: "2024-*"); an empty filter matches all the sheets.
+// All the sheets must share the same columns, read from the axis or the range of the reader.
+// A field tagged with meta:sheet receives the name of the sheet of each row.
+//
+// The sheets are all read even if one fails; the result of each sheet,
+// including its error, is available in Reader.Result.Sheets.
+func (e *Excel) UnmarshalAllSheets(container any, filter string, tags ...map[string]*Tags) error {
+	return e.UnmarshalAllSheetsContext(context.Background(), container, filter, tags...)
+}
+
+// UnmarshalAllSheetsContext works like UnmarshalAllSheets but stops reading as soon as the context is done.
+func (e *Excel) UnmarshalAllSheetsContext(ctx context.Context, container any, filter string, tags ...map[string]*Tags) error {
+	if e.File == nil {
+		return ErrFileIsNil
+	}
+	if e.Reader == nil {
+		return ErrConfigNotValid
+	}
+
+	// The container must be a pointer to a slice
+	v := reflect.ValueOf(container)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return ErrContainerInvalid
+	}
+
+	// Check the pattern
+	if _, err := path.Match(filter, ""); err != nil {
+		return fmt.Errorf("excel: invalid sheet filter '%s': %w", filter, err)
+	}
+
+	// Restore the sheet of the reader
+	current := e.Reader.Sheet
+	defer func() { e.Reader.Sheet = current }()
+
+	all := reflect.MakeSlice(v.Elem().Type(), 0, 0)
+	result := &ReaderResult{}
+	var errs []error
+
+	for sheet := range e.Sheets() {
+		if len(filter) > 0 {
+			if ok, _ := path.Match(filter, sheet.Name); !ok {
+				continue
+			}
+		}
+
+		// Stop if the operation has been cancelled
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		// Read the sheet
+		e.Reader.Sheet = *sheet
+		rows := reflect.New(v.Elem().Type())
+		err := e.UnmarshalContext(ctx, rows.Interface(), tags...)
+
+		sheetResult := SheetResult{Sheet: sheet.Name, Err: err}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("excel: failed to read sheet '%s': %w", sheet.Name, err))
+		} else if e.Reader.Result != nil {
+			sheetResult.Rows = e.Reader.Result.Rows
+			result.Rows += e.Reader.Result.Rows
+			result.Columns = max(result.Columns, e.Reader.Result.Columns)
+			result.OutlineLevels = append(result.OutlineLevels, e.Reader.Result.OutlineLevels...)
+		}
+		result.Sheets = append(result.Sheets, sheetResult)
+		all = reflect.AppendSlice(all, rows.Elem())
+	}
+
+	v.Elem().Set(all)
+	e.Reader.Result = result
+	return newMultiError(errs)
+}
@@ -29,7 +29,7 @@ func newSliceReader(reader *Reader, value reflect.Value) (*SliceReader, error) {
 func (r *SliceReader) Unmarshall() (*ReaderResult, error) {
 
 	// get excel rows
-	rows, startCol, err := r.Reader.getRows()
+	it, err := r.Reader.newRowIterator(0)
 	if err != nil {
 		return nil, err
 	}
@@ -41,37 +41,32 @@ func (r *SliceReader) Unmarshall() (*ReaderResult, error) {
 	result := &ReaderResult{}
 
 	// Loop throw all rows
-	for rows.Next() {
-		row, err := rows.Columns()
-		if err != nil {
-			break
-		}
-		if row == nil {
-			break
-		}
-
-		// Apply column offset if needed
-		if startCol > 0 && len(row) > startCol {
-			row = row[startCol:]
-		} else if startCol > 0 && len(row) <= startCol {
-			// Skip this row if it doesn't have enough columns
-			continue
-		}
+	for it.Next() {
+		row := it.Row()
 
 		value, err := r.unmarshallRow(row)
 		if err != nil {
+			_ = it.Close()
 			return nil, err
 		}
 
 		if value.IsValid() {
+			r.Reader.setOutlineLevel(result, value, it.Level())
 			slice = reflect.Append(slice, value)
 		}
 
+		// Report the progress
+		r.Reader.opts.notify(slice.Len())
+
 		// Set the result
-		if result.Rows == 0 {
+		if result.Columns == 0 {
 			result.Columns = len(row)
 		}
 	}
+	if err := it.Err(); err != nil {
+		_ = it.Close()
+		return nil, err
+	}
 
 	// Set the result
 	result.Rows = slice.Len()
@@ -79,7 +74,7 @@ func (r *SliceReader) Unmarshall() (*ReaderResult, error) {
 	// Set the slice to the container
 	r.container.Value.Elem().Set(slice)
 
-	return result, rows.Close()
+	return result, it.Close()
 }
 
 func (r *SliceReader) SetColumnsTags(_ map[string]*Tags) {
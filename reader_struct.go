@@ -1,8 +1,11 @@
 package excel
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+
+	"github.com/xuri/excelize/v2"
 )
 
 // StructReader is the Excel reader for a struct
@@ -11,6 +14,23 @@ type StructReader struct {
 	container *Container
 	Reader    *Reader
 	Struct    *Struct
+
+	// comments of the sheet, loaded when needed
+	comments map[string]string
+	// titles are the values of the title row
+	titles []string
+
+	// extraField holds the columns which are not mapped to a field
+	extraField   *Field
+	extraColumns []int
+
+	// repeatFields are the slice fields spread across repeated columns
+	repeatFields  []*Field
+	repeatColumns map[*Field][]int
+
+	// children reads the detail rows of a master-detail struct
+	children      *StructReader
+	childrenField *Field
 }
 
 // newStructReader create the appropriate reader
@@ -44,6 +64,16 @@ func newStructReader(reader *Reader, value reflect.Value) (*StructReader, error)
 		Reader:    reader,
 		Struct:    structInfo,
 	}
+	if err := r.newChildrenReader(); err != nil {
+		return nil, err
+	}
+	var err error
+	if r.extraField, err = structInfo.extraField(true); err != nil {
+		return nil, err
+	}
+	if r.repeatFields, err = structInfo.repeatFields(true); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
@@ -61,8 +91,14 @@ func (r *StructReader) Unmarshall() (*ReaderResult, error) {
 		return nil, fmt.Errorf("excel: struct or fields are nil")
 	}
 
+	// Locale of the numbers
+	r.Struct.applyLocale(r.Reader.opts.locale, true)
+	if r.children != nil {
+		r.children.Struct.applyLocale(r.Reader.opts.locale, true)
+	}
+
 	// get excel rows
-	rows, startCol, err := r.Reader.getRows()
+	it, err := r.Reader.newRowIterator(1)
 	if err != nil {
 		return nil, fmt.Errorf("excel: failed to get rows from sheet '%s': %w", r.Reader.Sheet.Name, err)
 	}
@@ -73,68 +109,100 @@ func (r *StructReader) Unmarshall() (*ReaderResult, error) {
 	// prepare the result
 	result := &ReaderResult{}
 
-	// Loop throw all rows
-	rowIndex := 0
-	for rows.Next() {
-		row, err := rows.Columns()
-		if err != nil {
-			return nil, fmt.Errorf("excel: failed to get columns for row %d: %w", rowIndex, err)
-		}
-		if row == nil {
-			break
-		}
+	// parents of the detail rows of a master-detail struct
+	parents := make(map[string]int)
 
-		// Apply column offset if needed
-		if startCol > 0 && len(row) > startCol {
-			row = row[startCol:]
-		} else if startCol > 0 {
-			// Skip this row if it doesn't have enough columns
-			rowIndex++
-			continue
-		}
+	// errors of the rows, collected with WithCellErrors
+	var errs []error
+
+	// Loop throw all rows
+	for it.Next() {
+		row := it.Row()
 
 		// Title row
-		if rowIndex == 0 {
+		if it.IsHeader() {
+			if r.Reader.opts.strict {
+				if err := r.checkSchema(row); err != nil {
+					_ = it.Close()
+					return nil, err
+				}
+			}
+
 			err := r.updateColumnIndex(row)
+			if err == nil && r.children != nil {
+				err = r.children.updateColumnIndex(row)
+			}
 			if err != nil {
-				if err == ErrColumnRequired {
-					return nil, ErrColumnRequired
+				_ = it.Close()
+				if errors.Is(err, ErrColumnRequired) {
+					return nil, err
 				}
 				return nil, fmt.Errorf("excel: failed to update column index: %w", err)
 			}
+
+			r.updateRepeatColumns()
+			r.updateExtraColumns()
+
+			// Set the result
+			result.Columns = len(row)
+			continue
 		}
 
-		// Data row
-		if rowIndex > 0 {
-			value, err := r.unmarshallRow(row)
-			if err != nil {
-				return nil, fmt.Errorf("excel: failed to unmarshall row %d: %w", rowIndex, err)
+		// Detail row
+		if r.children != nil {
+			if slice, err = r.appendDetailRow(slice, parents, row, it.RowNum()); err != nil {
+				if r.Reader.opts.cellErrors {
+					errs = append(errs, r.rowError(it.RowNum(), err))
+					continue
+				}
+				_ = it.Close()
+				return nil, r.rowError(it.RowNum(), err)
 			}
+			r.Reader.opts.notify(slice.Len())
+			continue
+		}
 
-			if value.IsValid() {
-				slice = reflect.Append(slice, value)
+		// Data row
+		value, err := r.unmarshallRow(row, it.RowNum())
+		if err != nil {
+			if r.Reader.opts.cellErrors {
+				errs = append(errs, r.rowError(it.RowNum(), err))
+				continue
 			}
+			_ = it.Close()
+			return nil, r.rowError(it.RowNum(), err)
 		}
 
-		// Set the result
-		if result.Rows == 0 {
-			result.Columns = len(row)
+		if value.IsValid() {
+			r.Reader.setOutlineLevel(result, value, it.Level())
+			slice = reflect.Append(slice, value)
 		}
 
-		// Next row
-		rowIndex++
+		// Report the progress
+		r.Reader.opts.notify(slice.Len())
+	}
+	if err := it.Err(); err != nil {
+		_ = it.Close()
+		return nil, err
+	}
+
+	// All the invalid rows are returned together
+	if err := newMultiError(errs); err != nil {
+		_ = it.Close()
+		return nil, err
 	}
 
 	// Set the result
-	result.Rows = rowIndex
+	result.Rows = it.Count()
 
 	// Set the slice to the container
 	if !r.container.Value.Elem().CanSet() {
+		_ = it.Close()
 		return nil, fmt.Errorf("excel: container value cannot be set")
 	}
 	r.container.Value.Elem().Set(slice)
 
-	return result, rows.Close()
+	return result, it.Close()
 }
 
 func (r *StructReader) SetColumnsTags(tags map[string]*Tags) {
@@ -162,6 +230,7 @@ func (r *StructReader) updateColumnIndex(row []string) error {
 	if row == nil {
 		return fmt.Errorf("excel: row is nil")
 	}
+	r.titles = row
 
 	// Initialize all fields index
 	for _, f := range r.Struct.Fields {
@@ -178,13 +247,15 @@ func (r *StructReader) updateColumnIndex(row []string) error {
 		}
 		// Required column
 		if f.GetReadRequired() && f.ReadTags.index == -1 {
-			return ErrColumnRequired
+			return &ColumnError{Column: f.GetReadColumnName(), Field: f.Name, Err: ErrColumnRequired}
 		}
 	}
 	return nil
 }
 
-func (r *StructReader) unmarshallRow(row []string) (value reflect.Value, err error) {
+// unmarshallRow decodes a data row into a new element of the container.
+// rowNum is the 1-based row number in the sheet.
+func (r *StructReader) unmarshallRow(row []string, rowNum int) (value reflect.Value, err error) {
 	if r == nil || r.container == nil || r.Struct == nil || r.Struct.Fields == nil {
 		return reflect.Value{}, fmt.Errorf("excel: struct reader, container, struct or fields are nil")
 	}
@@ -198,25 +269,28 @@ func (r *StructReader) unmarshallRow(row []string) (value reflect.Value, err err
 		return reflect.Value{}, fmt.Errorf("excel: failed to create new container value")
 	}
 
+	// Call the before hook
+	if hook, ok := hookTarget(containerValue).(BeforeUnmarshalRowHook); ok {
+		if err = hook.BeforeUnmarshalRow(rowNum); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
 	// Loop throw all fields
+	var invalid []error
 	for _, fieldConfig := range r.Struct.Fields {
 		if fieldConfig == nil {
 			continue
 		}
 
 		if fieldConfig.ReadTags.index >= 0 {
-			var fieldValue reflect.Value
-
-			if len(row) >= fieldConfig.ReadTags.index+1 {
-				fieldValue, err = fieldConfig.convertToValue(row[fieldConfig.ReadTags.index])
-				if err != nil {
-					// Log the error but continue with other fields
-					// This allows partial data to be read even if some fields fail
-					continue
-				}
-			} else {
-				// Use default value if the column is out of range
-				fieldValue = reflect.ValueOf(fieldConfig.GetReadDefault())
+			fieldValue, err := r.readField(fieldConfig, row, rowNum)
+			if errors.Is(err, ErrCellNotValid) {
+				invalid = append(invalid, err)
+				continue
+			}
+			if err != nil {
+				return reflect.Value{}, err
 			}
 
 			// Assign the value to the containerValue
@@ -234,5 +308,189 @@ func (r *StructReader) unmarshallRow(row []string) (value reflect.Value, err err
 		}
 	}
 
+	// Fill the fields spread across repeated columns
+	repeatInvalid, err := r.readRepeat(containerValue, row, rowNum)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("excel: failed to read repeated columns: %w", err)
+	}
+	invalid = append(invalid, repeatInvalid...)
+
+	// All the invalid cells of the row are returned together
+	if err = newMultiError(invalid); err != nil {
+		return reflect.Value{}, err
+	}
+
+	// Fill the field holding the columns which are not mapped
+	if err = r.readExtra(containerValue, row); err != nil {
+		return reflect.Value{}, fmt.Errorf("excel: failed to read extra columns: %w", err)
+	}
+
+	// Fill the fields holding the metadata of the row
+	if err = r.readMeta(containerValue, row, rowNum); err != nil {
+		return reflect.Value{}, err
+	}
+
+	// Fill the fields holding the comments of other fields
+	if err = r.readCommentFrom(containerValue, rowNum); err != nil {
+		return reflect.Value{}, fmt.Errorf("excel: failed to read comments: %w", err)
+	}
+
+	// Call the after hook
+	if hook, ok := hookTarget(containerValue).(AfterUnmarshalRowHook); ok {
+		if err = hook.AfterUnmarshalRow(rowNum); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
 	return containerValue, nil
 }
+
+// readField reads the value of a field from a data row.
+// An invalid value is returned if the cell can't be converted to the field type.
+func (r *StructReader) readField(f *Field, row []string, rowNum int) (reflect.Value, error) {
+	// Column number of the field in the sheet
+	col := r.Reader.Axis.Col + f.ReadTags.index
+
+	// Formatted value of the cell
+	var cell string
+	inRow := len(row) >= f.ReadTags.index+1
+	if inRow {
+		cell = row[f.ReadTags.index]
+	}
+
+	switch {
+	case f.isAnnotated():
+		// Read the value and the comment of the cell
+		value, err := r.readAnnotated(f, col, rowNum, cell)
+		if err != nil {
+			return reflect.Value{}, r.cellError(f, col, rowNum, cell, fmt.Errorf("excel: failed to read comment: %w", err))
+		}
+		return value, nil
+
+	case f.isNullable(true):
+		// Empty cells give invalid values
+		return r.readNullable(f, row, rowNum, cell)
+
+	case f.isCell():
+		// Read the cell and its metadata
+		value, err := r.readCell(col, rowNum, cell)
+		if err != nil {
+			return reflect.Value{}, r.cellError(f, col, rowNum, cell, err)
+		}
+		return pointerTo(value, f.Type), nil
+
+	case f.isHyperlink():
+		// Read the text and the target of the link
+		value, err := r.readHyperlink(col, rowNum, cell)
+		if err != nil {
+			return reflect.Value{}, r.cellError(f, col, rowNum, cell, fmt.Errorf("excel: failed to read hyperlink: %w", err))
+		}
+		return pointerTo(value, f.Type), nil
+
+	case f.readSource() != SourceValue:
+		// Read the value from another source than the formatted value
+		source := f.readSource()
+		from, err := r.readSource(source, col, rowNum)
+		if err != nil {
+			return reflect.Value{}, r.cellError(f, col, rowNum, cell, fmt.Errorf("excel: failed to read %s: %w", source, err))
+		}
+		if f.isFormula() {
+			return pointerTo(reflect.ValueOf(Formula(from)), f.Type), nil
+		}
+		value, err := f.convertToValue(from)
+		if err != nil {
+			return reflect.Value{}, r.invalidCell(f, col, rowNum, from, err)
+		}
+		return value, nil
+
+	case inRow && len(cell) > 0 && (f.isLocalized() || f.isExact()):
+		// Numbers stored as numbers don't follow the conventions of the locale
+		// and exact numbers are not rounded by the format of the cell
+		raw, numeric, err := r.readRawNumber(col, rowNum)
+		if err != nil {
+			return reflect.Value{}, r.cellError(f, col, rowNum, cell, err)
+		}
+		if numeric {
+			cell, f = raw, f.withoutLocale()
+		}
+		value, err := f.convertToValue(cell)
+		if err != nil {
+			return reflect.Value{}, r.invalidCell(f, col, rowNum, cell, err)
+		}
+		return value, nil
+
+	case inRow:
+		value, err := f.convertToValue(cell)
+		if err != nil && isNumber(indirectType(f.Type).Kind()) {
+			// Numbers with a format which can't be parsed are read from their raw value
+			if raw, ok, rawErr := r.readRawNumber(col, rowNum); rawErr != nil {
+				return reflect.Value{}, r.cellError(f, col, rowNum, cell, rawErr)
+			} else if ok {
+				value, err = f.convertToValue(raw)
+			}
+		}
+		if err != nil {
+			// The field is left empty so that partial data can be read,
+			// unless the invalid cells are reported with WithCellErrors
+			return reflect.Value{}, r.invalidCell(f, col, rowNum, cell, err)
+		}
+		return value, nil
+
+	default:
+		// Use default value if the column is out of range
+		return reflect.ValueOf(f.GetReadDefault()), nil
+	}
+}
+
+// pointerTo returns a pointer to the value if the type t is a pointer to the type of the value
+func pointerTo(v reflect.Value, t reflect.Type) reflect.Value {
+	if t.Kind() == reflect.Pointer && v.IsValid() && v.Type() == t.Elem() {
+		p := reflect.New(t.Elem())
+		p.Elem().Set(v)
+		return p
+	}
+	return v
+}
+
+// cellError returns the error of a cell of a field
+func (r *StructReader) cellError(f *Field, col int, rowNum int, value string, err error) *CellError {
+	cell, _ := excelize.CoordinatesToCellName(col, rowNum)
+	return &CellError{
+		Sheet: r.Reader.Sheet.Name,
+		Cell:  cell,
+		Row:   rowNum,
+		Col:   col,
+		Value: value,
+		Type:  f.Type.String(),
+		Err:   err,
+	}
+}
+
+// invalidCell returns the error of a value which can't be converted to the type of its field.
+// Invalid values are only reported with WithCellErrors or in strict mode.
+func (r *StructReader) invalidCell(f *Field, col int, rowNum int, value string, err error) error {
+	if !r.Reader.opts.cellErrors {
+		return nil
+	}
+	return r.cellError(f, col, rowNum, value, fmt.Errorf("%w: %w", ErrCellNotValid, err))
+}
+
+// rowError returns the error of a row
+func (r *StructReader) rowError(rowNum int, err error) error {
+	var rowErr *RowError
+	if errors.As(err, &rowErr) {
+		return err
+	}
+	return &RowError{Sheet: r.Reader.Sheet.Name, Row: rowNum, Err: err}
+}
+
+// readRawNumber returns the raw value of a cell stored as a number.
+// It returns false if the cell is not stored as a number.
+func (r *StructReader) readRawNumber(col int, row int) (string, bool, error) {
+	numeric, err := r.isNumberCell(col, row)
+	if err != nil || !numeric {
+		return "", false, err
+	}
+	raw, err := r.readSource(SourceRaw, col, row)
+	return raw, err == nil, err
+}
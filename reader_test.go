@@ -1,7 +1,13 @@
 package excel
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -523,10 +529,14 @@ func TestRequiredFields(t *testing.T) {
 	inExcel.SetAxis("A1")
 
 	err := inExcel.Unmarshal(&simpleUsers)
-	if err != ErrColumnRequired {
+	if !errors.Is(err, ErrColumnRequired) {
 		t.Error("Required column error")
 		return
 	}
+	var columnErr *ColumnError
+	if !errors.As(err, &columnErr) || columnErr.Column != "Name" || columnErr.Field != "Name" {
+		t.Errorf("Required column error should name the column, got %v", err)
+	}
 }
 
 // TestCustomTypeConverter verifies custom type conversion functionality.
@@ -856,3 +866,863 @@ func TestStructNamedUserRead(t *testing.T) {
 	assert.Equal(t, 2, namedUsers[0].AnArray[1], "Second array element should be 2")
 	assert.Equal(t, 3, namedUsers[0].AnArray[2], "Third array element should be 3")
 }
+
+// TestUnmarshalContext verifies that reading can be cancelled and reports its progress.
+// It tests:
+// - Progress callback calls
+// - Cancellation between rows
+func TestUnmarshalContext(t *testing.T) {
+
+	file := excelize.NewFile()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetCellValue(sheet, "A1", "Id")
+	_ = file.SetCellValue(sheet, "B1", "Name")
+	for i := 2; i <= 11; i++ {
+		_ = file.SetCellValue(sheet, "A"+strconv.Itoa(i), i-1)
+		_ = file.SetCellValue(sheet, "B"+strconv.Itoa(i), "name")
+	}
+	defer func() { _ = file.Close() }()
+
+	var progress []int
+	xl, _ := NewReader(file, WithProgress(func(rowsDone int) {
+		progress = append(progress, rowsDone)
+	}))
+
+	var named []Named
+	err := xl.UnmarshalContext(context.Background(), &named)
+	assert.NoError(t, err)
+	assert.Len(t, named, 10)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, progress)
+
+	// Cancel the context after the third row
+	ctx, cancel := context.WithCancel(context.Background())
+	xl.SetOptions(WithProgress(func(rowsDone int) {
+		if rowsDone == 3 {
+			cancel()
+		}
+	}))
+
+	named = nil
+	err = xl.UnmarshalContext(ctx, &named)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, named, 0)
+}
+
+// HookedUser implements the row hooks
+type HookedUser struct {
+	ID   int    `excel:"Id"`
+	Name string `excel:"Name"`
+	Row  int    `excel:"-"`
+}
+
+// AfterUnmarshalRow normalises the name and keeps the row number
+func (u *HookedUser) AfterUnmarshalRow(row int) error {
+	if u.Name == "invalid" {
+		return errors.New("invalid name")
+	}
+	u.Name = strings.ToUpper(u.Name)
+	u.Row = row
+	return nil
+}
+
+// BeforeMarshalRow normalises the name before writing
+func (u *HookedUser) BeforeMarshalRow() error {
+	u.Name = strings.ToLower(u.Name)
+	return nil
+}
+
+// TestRowHooks verifies the row hooks and the row filter and transform options.
+// It tests:
+// - AfterUnmarshalRow hook
+// - Row filter and row transform when reading and writing
+// - Errors returned by the hooks
+func TestRowHooks(t *testing.T) {
+
+	file := excelize.NewFile()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "B2", &[]any{"Id", "Name"})
+	_ = file.SetSheetRow(sheet, "B3", &[]any{1, "john"})
+	_ = file.SetSheetRow(sheet, "B4", &[]any{"#", "comment"})
+	_ = file.SetSheetRow(sheet, "B5", &[]any{2, " jane "})
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewReader(file,
+		WithRowFilter(func(raw []string) bool { return raw[0] != "#" }),
+		WithRowTransform(func(raw []string) []string {
+			for i := range raw {
+				raw[i] = strings.TrimSpace(raw[i])
+			}
+			return raw
+		}),
+	)
+	xl.SetAxis("B2")
+
+	var hooked []HookedUser
+	err := xl.Unmarshal(&hooked)
+	assert.NoError(t, err)
+	assert.Equal(t, []HookedUser{{ID: 1, Name: "JOHN", Row: 3}, {ID: 2, Name: "JANE", Row: 5}}, hooked)
+
+	// Errors returned by the hooks stop the reading
+	_ = file.SetCellValue(sheet, "C3", "invalid")
+	err = xl.Unmarshal(&hooked)
+	assert.Error(t, err)
+
+	// The before hook is called when writing
+	out := excelize.NewFile()
+	defer func() { _ = out.Close() }()
+
+	outExcel, _ := NewWriter(out)
+	err = outExcel.Marshal(&[]*HookedUser{{ID: 1, Name: "JOHN"}})
+	assert.NoError(t, err)
+
+	value, _ := out.GetCellValue(outExcel.Sheet().Name, "B2")
+	assert.Equal(t, "john", value)
+
+	// The row filter and the row transform are called when writing
+	filtered := excelize.NewFile()
+	defer func() { _ = filtered.Close() }()
+
+	outExcel, _ = NewWriter(filtered,
+		WithRowFilter(func(encoded []string) bool { return encoded[0] != "2" }),
+		WithRowTransform(func(encoded []string) []string {
+			encoded[1] = strings.ToUpper(encoded[1])
+			return encoded
+		}),
+	)
+	err = outExcel.Marshal(&[]HookedUser{{ID: 1, Name: "John"}, {ID: 2, Name: "Jane"}, {ID: 3, Name: "Jack"}})
+	assert.NoError(t, err)
+
+	rows, _ := filtered.GetRows(outExcel.Sheet().Name)
+	assert.Equal(t, [][]string{{"Id", "Name"}, {"1", "JOHN"}, {"3", "JACK"}}, rows)
+}
+
+// TestReaderLimits verifies the options which limit the rows read.
+// It tests:
+// - Stop at empty rows
+// - End marker
+// - Maximum number of rows
+// - Skipped rows
+func TestReaderLimits(t *testing.T) {
+
+	file := excelize.NewFile()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Id", "Name"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{1, "John"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{2, "Jane"})
+	_ = file.SetSheetRow(sheet, "A4", &[]any{"", ""})
+	_ = file.SetSheetRow(sheet, "A5", &[]any{3, "Jack"})
+	_ = file.SetSheetRow(sheet, "A6", &[]any{"", ""})
+	_ = file.SetSheetRow(sheet, "A7", &[]any{"", ""})
+	_ = file.SetSheetRow(sheet, "A8", &[]any{"Total", 6})
+	defer func() { _ = file.Close() }()
+
+	t.Run("StopAtEmptyRow", func(t *testing.T) {
+		xl, _ := NewReader(file, WithStopAtEmptyRows(1))
+		var named []Named
+		assert.NoError(t, xl.Unmarshal(&named))
+		assert.Len(t, named, 2)
+	})
+
+	t.Run("StopAtEmptyRows", func(t *testing.T) {
+		xl, _ := NewReader(file, WithStopAtEmptyRows(2))
+		var named []Named
+		assert.NoError(t, xl.Unmarshal(&named))
+		assert.Len(t, named, 3)
+		assert.Equal(t, "Jack", named[2].Name)
+	})
+
+	t.Run("EndMarker", func(t *testing.T) {
+		xl, _ := NewReader(file, WithEndMarker("Total"), WithStopAtEmptyRows(3))
+		var maps StringMap
+		assert.NoError(t, xl.Unmarshal(&maps))
+		assert.Len(t, maps, 3)
+		assert.Equal(t, "Jack", maps[2]["Name"])
+	})
+
+	t.Run("MaxRows", func(t *testing.T) {
+		xl, _ := NewReader(file, WithMaxRows(2))
+		var matrix StringMatrix
+		assert.NoError(t, xl.Unmarshal(&matrix))
+		assert.Equal(t, StringMatrix{{"Id", "Name"}, {"1", "John"}}, matrix)
+	})
+
+	t.Run("SkipRows", func(t *testing.T) {
+		xl, _ := NewReader(file,
+			WithSkipRows(func(raw []string) bool { return raw[1] == "Jane" }),
+			WithStopAtEmptyRows(2),
+		)
+		var named []Named
+		assert.NoError(t, xl.Unmarshal(&named))
+		assert.Len(t, named, 2)
+		assert.Equal(t, "Jack", named[1].Name)
+	})
+}
+
+// TestRangeRead verifies that only the cells inside the range are read.
+// It tests:
+// - Side by side blocks
+// - Bounded rows and columns
+// - Range validation
+func TestRangeRead(t *testing.T) {
+
+	file := excelize.NewFile()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Id", "Name", "", "Id", "Name"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{1, "John", "", 10, "Ten"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{2, "Jane", "", 20, "Twenty"})
+	_ = file.SetSheetRow(sheet, "A4", &[]any{"Total", "", "", 30, "Thirty"})
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewReader(file)
+
+	assert.NoError(t, xl.SetRange("A1:B3"))
+	var matrix StringMatrix
+	assert.NoError(t, xl.Unmarshal(&matrix))
+	assert.Equal(t, StringMatrix{{"Id", "Name"}, {"1", "John"}, {"2", "Jane"}}, matrix)
+
+	assert.NoError(t, xl.SetRange("D1:E3"))
+	var named []Named
+	assert.NoError(t, xl.Unmarshal(&named))
+	assert.Equal(t, []Named{{ID: 10, Name: "Ten"}, {ID: 20, Name: "Twenty"}}, named)
+
+	assert.NoError(t, xl.SetRange("$D$1:$D$4"))
+	var maps StringMap
+	assert.NoError(t, xl.Unmarshal(&maps))
+	assert.Equal(t, StringMap{{"Id": "10"}, {"Id": "20"}, {"Id": "30"}}, maps)
+
+	// Setting an axis removes the range
+	xl.SetAxis("D1")
+	named = nil
+	assert.NoError(t, xl.Unmarshal(&named))
+	assert.Len(t, named, 3)
+
+	assert.Error(t, xl.SetRange("D1"))
+	assert.ErrorIs(t, xl.SetRange("D4:A1"), ErrRangeNotValid)
+}
+
+// TestCellSourceRead verifies reading cell metadata.
+// It tests:
+// - Formula, hyperlink, comment and raw value sources
+// - Cell field type
+func TestCellSourceRead(t *testing.T) {
+
+	type Sourced struct {
+		Name    string  `excel:"Name"`
+		Link    string  `excel:"Name,source:hyperlink"`
+		Note    string  `excel:"Name,source:comment"`
+		Price   float64 `excel:"Price,source:raw"`
+		Formula string  `excel:"Total,source:formula"`
+		Total   Cell    `excel:"Total"`
+		PriceP  *Cell   `excel:"Price"`
+	}
+
+	file := excelize.NewFile()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Price", "Total"})
+	_ = file.SetCellValue(sheet, "A2", "Go")
+	_ = file.SetCellHyperLink(sheet, "A2", "https://go.dev", "External")
+	_ = file.AddComment(sheet, excelize.Comment{Cell: "A2", Author: "Reviewer", Text: "checked"})
+	_ = file.SetCellValue(sheet, "B2", 1234.5)
+	style, _ := file.NewStyle(&excelize.Style{NumFmt: 4})
+	_ = file.SetCellStyle(sheet, "B2", "B2", style)
+	_ = file.SetCellFormula(sheet, "C2", "B2*2")
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewReader(file)
+
+	var sourced []Sourced
+	err := xl.Unmarshal(&sourced)
+	assert.NoError(t, err)
+	assert.Len(t, sourced, 1)
+
+	s := sourced[0]
+	assert.Equal(t, "Go", s.Name)
+	assert.Equal(t, "https://go.dev", s.Link)
+	assert.Equal(t, "checked", s.Note)
+	assert.Equal(t, 1234.5, s.Price)
+	assert.Equal(t, "B2*2", s.Formula)
+	assert.Equal(t, Cell{Formula: "B2*2", Axis: "C2", Col: 3, Row: 2}, s.Total)
+	assert.NotNil(t, s.PriceP)
+	assert.Equal(t, "1,234.50", s.PriceP.Value)
+	assert.Equal(t, style, s.PriceP.StyleID)
+	assert.Equal(t, "B2", s.PriceP.Axis)
+}
+
+// TestUnmarshalAllSheets verifies reading several sheets into one container.
+// It tests:
+// - Sheets matched by a pattern
+// - meta:sheet field and results per sheet
+// - Errors reported per sheet
+func TestUnmarshalAllSheets(t *testing.T) {
+	type Sale struct {
+		Branch string  `excel:"meta:sheet"`
+		Item   string  `excel:"Item"`
+		Amount float64 `excel:"Amount"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	_ = file.SetSheetName("Sheet1", "Branch-Paris")
+	_, _ = file.NewSheet("Lookup")
+	_, _ = file.NewSheet("Branch-Lyon")
+	_, _ = file.NewSheet("Branch-Nice")
+	_ = file.SetSheetRow("Branch-Paris", "A1", &[]any{"Item", "Amount"})
+	_ = file.SetSheetRow("Branch-Paris", "A2", &[]any{"Pen", 2.5})
+	_ = file.SetSheetRow("Branch-Paris", "A3", &[]any{"Ink", 4})
+	_ = file.SetSheetRow("Lookup", "A1", &[]any{"Code", "Label"})
+	_ = file.SetSheetRow("Branch-Lyon", "A1", &[]any{"Amount", "Item"})
+	_ = file.SetSheetRow("Branch-Lyon", "A2", &[]any{10, "Book"})
+
+	xl, _ := NewReader(file)
+	xl.SetSheetFromName("Lookup")
+
+	var sales []Sale
+	err := xl.UnmarshalAllSheets(&sales, "Branch-*")
+	assert.NoError(t, err)
+	assert.Equal(t, []Sale{
+		{Branch: "Branch-Paris", Item: "Pen", Amount: 2.5},
+		{Branch: "Branch-Paris", Item: "Ink", Amount: 4},
+		{Branch: "Branch-Lyon", Item: "Book", Amount: 10},
+	}, sales)
+	assert.Equal(t, 5, xl.Reader.Result.Rows)
+	assert.Equal(t, []SheetResult{
+		{Sheet: "Branch-Paris", Rows: 3},
+		{Sheet: "Branch-Lyon", Rows: 2},
+		{Sheet: "Branch-Nice", Rows: 0},
+	}, xl.Reader.Result.Sheets)
+	assert.Equal(t, "Lookup", xl.Reader.Sheet.Name)
+
+	// The meta field is filled by a single sheet read too
+	xl.SetSheetFromName("Branch-Lyon")
+	err = xl.Unmarshal(&sales)
+	assert.NoError(t, err)
+	assert.Equal(t, "Branch-Lyon", sales[0].Branch)
+
+	// Errors are reported per sheet
+	type Strict struct {
+		Item string `excel:"Item,required"`
+	}
+	var items []Strict
+	err = xl.UnmarshalAllSheets(&items, "")
+	assert.ErrorIs(t, err, ErrColumnRequired)
+	assert.Len(t, items, 3)
+	assert.Len(t, xl.Reader.Result.Sheets, 4)
+	assert.ErrorIs(t, xl.Reader.Result.Sheets[1].Err, ErrColumnRequired)
+	assert.NoError(t, xl.Reader.Result.Sheets[3].Err)
+
+	err = xl.UnmarshalAllSheets(&items, "[")
+	assert.Error(t, err)
+}
+
+// TestMetaRead verifies the metadata fields filled when reading.
+// It tests:
+// - meta:row, meta:sheet, meta:cell and meta:raw tags
+// - Metadata fields not written
+// - Invalid metadata field types
+func TestMetaRead(t *testing.T) {
+	type Located struct {
+		Row   int      `excel:"meta:row"`
+		Sheet string   `excel:"meta:sheet"`
+		Cell  string   `excel:"meta:cell:Price"`
+		Raw   []string `excel:"meta:raw"`
+		Name  string   `excel:"Name"`
+		Price float64  `excel:"Price"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "B3", &[]any{"Name", "Price"})
+	_ = file.SetSheetRow(sheet, "B4", &[]any{"Pen", 2.5})
+	_ = file.SetSheetRow(sheet, "B6", &[]any{"Ink", "n/a"})
+
+	xl, _ := NewReader(file, WithStopAtEmptyRows(2))
+	xl.SetAxis("B3")
+
+	var located []Located
+	err := xl.Unmarshal(&located)
+	assert.NoError(t, err)
+	assert.Equal(t, []Located{
+		{Row: 4, Sheet: sheet, Cell: "C4", Raw: []string{"Pen", "2.5"}, Name: "Pen", Price: 2.5},
+		{Row: 6, Sheet: sheet, Cell: "C6", Raw: []string{"Ink", "n/a"}, Name: "Ink"},
+	}, located)
+
+	// The metadata fields are not written
+	out := excelize.NewFile()
+	defer func() { _ = out.Close() }()
+	xw, _ := NewWriter(out)
+	assert.NoError(t, xw.Marshal(&located))
+	rows, _ := out.GetRows(out.GetSheetName(0))
+	assert.Equal(t, []string{"Name", "Price"}, rows[0])
+
+	// A meta field must be able to hold the metadata
+	type Wrong struct {
+		Row string `excel:"meta:row"`
+	}
+	var wrong []Wrong
+	assert.Error(t, xl.Unmarshal(&wrong))
+}
+
+// TestExtraColumns verifies the columns which are not mapped to a field.
+// It tests:
+// - extra tag when reading
+// - Extra columns written after the other columns
+// - Extra values of any type
+func TestExtraColumns(t *testing.T) {
+	type Product struct {
+		Name  string            `excel:"Name"`
+		Price float64           `excel:"Price"`
+		Extra map[string]string `excel:",extra"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Origin", "Price", "Stock"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Pen", "FR", 2.5, 10})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Ink", "", 4})
+
+	xl, _ := NewReader(file)
+
+	var products []Product
+	err := xl.Unmarshal(&products)
+	assert.NoError(t, err)
+	assert.Equal(t, []Product{
+		{Name: "Pen", Price: 2.5, Extra: map[string]string{"Origin": "FR", "Stock": "10"}},
+		{Name: "Ink", Price: 4, Extra: map[string]string{"Origin": "", "Stock": ""}},
+	}, products)
+
+	// The extra columns are written after the other columns
+	out := excelize.NewFile()
+	defer func() { _ = out.Close() }()
+	xw, _ := NewWriter(out)
+	assert.NoError(t, xw.Marshal(&products))
+	assert.Equal(t, 4, xw.Writer.Result.Columns)
+	rows, _ := out.GetRows(out.GetSheetName(0))
+	assert.Equal(t, [][]string{
+		{"Name", "Price", "Origin", "Stock"},
+		{"Pen", "2.5", "FR", "10"},
+		{"Ink", "4"},
+	}, rows)
+
+	// Round trip
+	xr, _ := NewReader(out)
+	var again []Product
+	assert.NoError(t, xr.Unmarshal(&again))
+	assert.Equal(t, products, again)
+
+	// Any values
+	type Loose struct {
+		Name  string         `excel:"Name"`
+		Extra map[string]any `excel:",extra"`
+	}
+	var loose []Loose
+	assert.NoError(t, xl.Unmarshal(&loose))
+	assert.Equal(t, map[string]any{"Origin": "FR", "Price": "2.5", "Stock": "10"}, loose[0].Extra)
+
+	// The extra field must be a map of strings
+	type Wrong struct {
+		Extra map[string]int `excel:",extra"`
+	}
+	var wrong []Wrong
+	assert.Error(t, xl.Unmarshal(&wrong))
+}
+
+// TestStrictRead verifies the checks of the columns in strict mode.
+// It tests:
+// - Unknown, duplicate and missing columns
+// - SchemaError and its message
+// - Optional, pointer and default fields not required
+func TestStrictRead(t *testing.T) {
+	type Product struct {
+		Name  string   `excel:"Name"`
+		Price float64  `excel:"Price"`
+		Stock int      `excel:"Stock"`
+		Note  string   `excel:"Note,optional"`
+		Tax   *float64 `excel:"Tax"`
+		Unit  string   `excel:"Unit,default:pcs"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Price", "Origin", "Price", "Color"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Pen", 2.5, "FR", 3, "Blue"})
+
+	xl, _ := NewReader(file, Strict())
+
+	var products []Product
+	err := xl.Unmarshal(&products)
+	assert.ErrorIs(t, err, ErrSchemaNotValid)
+	var schema *SchemaError
+	assert.True(t, errors.As(err, &schema))
+	assert.Equal(t, &SchemaError{
+		Sheet:      sheet,
+		Unknown:    []string{"Origin", "Color"},
+		Duplicates: []string{"Price"},
+		Missing:    []string{"Stock"},
+	}, schema)
+	assert.Equal(t, "excel: the columns of sheet 'Sheet1' are not valid: unknown columns Origin, Color; duplicate columns Price; missing columns Stock", err.Error())
+
+	// Valid columns
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Price", "Stock"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Pen", 2.5, 10})
+	_ = file.SetCellValue(sheet, "D1", nil)
+	_ = file.SetCellValue(sheet, "E1", nil)
+	_ = file.SetCellValue(sheet, "D2", nil)
+	_ = file.SetCellValue(sheet, "E2", nil)
+	assert.NoError(t, xl.Unmarshal(&products))
+	assert.Equal(t, 10, products[0].Stock)
+
+	// Unknown columns are allowed without strict mode
+	_ = file.SetCellValue(sheet, "D1", "Origin")
+	xl, _ = NewReader(file)
+	assert.NoError(t, xl.Unmarshal(&products))
+}
+
+// TestReadErrors verifies the errors of the cells which can't be read.
+// It tests:
+// - Invalid values left empty by default
+// - RowError and CellError reported in strict mode and with WithCellErrors
+// - Position, value and type of the invalid cells
+func TestReadErrors(t *testing.T) {
+	type Product struct {
+		Name  string  `excel:"Name"`
+		Price float64 `excel:"Price"`
+		Stock int     `excel:"Stock"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Price", "Stock"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Pen", "cheap", "many"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Ink", 4, 2})
+	_ = file.SetSheetRow(sheet, "A4", &[]any{"Book", "n/a", 1})
+
+	// Invalid values are left empty by default
+	xl, _ := NewReader(file)
+	var products []Product
+	assert.NoError(t, xl.Unmarshal(&products))
+	assert.Len(t, products, 3)
+
+	// All the invalid cells are reported in strict mode
+	xl, _ = NewReader(file, Strict())
+	err := xl.Unmarshal(&products)
+	assert.ErrorIs(t, err, ErrCellNotValid)
+
+	var multi *MultiError
+	assert.True(t, errors.As(err, &multi))
+	assert.Len(t, multi.Errors, 2)
+
+	var rowErr *RowError
+	assert.True(t, errors.As(multi.Errors[0], &rowErr))
+	assert.Equal(t, 2, rowErr.Row)
+	assert.Equal(t, sheet, rowErr.Sheet)
+
+	var cellErr *CellError
+	assert.True(t, errors.As(multi.Errors[1], &cellErr))
+	assert.Equal(t, "B4", cellErr.Cell)
+	assert.Equal(t, 4, cellErr.Row)
+	assert.Equal(t, 2, cellErr.Col)
+	assert.Equal(t, "n/a", cellErr.Value)
+	assert.Equal(t, "float64", cellErr.Type)
+
+	var cells []*CellError
+	for _, e := range rowErr.Err.(*MultiError).Errors {
+		var c *CellError
+		if errors.As(e, &c) {
+			cells = append(cells, c)
+		}
+	}
+	assert.Len(t, cells, 2)
+	assert.Equal(t, "B2", cells[0].Cell)
+	assert.Equal(t, "C2", cells[1].Cell)
+	assert.Equal(t, "int", cells[1].Type)
+
+	// The invalid cells are reported without checking the columns
+	_ = file.SetCellValue(sheet, "D1", "Origin")
+	xl, _ = NewReader(file, WithCellErrors())
+	err = xl.Unmarshal(&products)
+	assert.ErrorIs(t, err, ErrCellNotValid)
+	assert.NotErrorIs(t, err, ErrSchemaNotValid)
+	assert.True(t, errors.As(err, &multi))
+	assert.Len(t, multi.Errors, 2)
+
+	// Tables and columns
+	xl, _ = NewReader(file)
+	_, err = xl.GetTable("Unknown")
+	assert.ErrorIs(t, err, ErrTableNotFound)
+	assert.ErrorIs(t, xl.AddTable(nil), ErrTableIsNil)
+}
+
+// TestLocaleRead verifies reading localized values.
+// It tests:
+// - WithLocale option and locale tag
+// - bool labels and percent tag
+// - Unknown labels in strict mode
+func TestLocaleRead(t *testing.T) {
+	type Supply struct {
+		Name     string  `excel:"Name"`
+		Price    float64 `excel:"Price"`
+		Quantity int     `excel:"Quantity,locale:de-DE"`
+		Organic  bool    `excel:"Organic,bool:Oui:true|Non:false"`
+		Margin   float64 `excel:"Margin,percent"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Price", "Quantity", "Organic", "Margin"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Pomme", "1 234,56", "1.200", "Oui", "45 %"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Poire", 12.5, 3, "non", 0.1})
+
+	xl, _ := NewReader(file, WithLocale("fr-FR"))
+
+	var supplies []Supply
+	err := xl.Unmarshal(&supplies)
+	assert.NoError(t, err)
+	assert.Equal(t, []Supply{
+		{Name: "Pomme", Price: 1234.56, Quantity: 1200, Organic: true, Margin: 0.45},
+		{Name: "Poire", Price: 12.5, Quantity: 3, Organic: false, Margin: 0.1},
+	}, supplies)
+
+	// Unknown labels are invalid
+	_ = file.SetCellValue(sheet, "D3", "Peut-être")
+	xl, _ = NewReader(file, WithLocale("fr-FR"), Strict())
+	err = xl.Unmarshal(&supplies)
+	assert.ErrorIs(t, err, ErrBoolNotValid)
+}
+
+// TestFormattedNumbersRead verifies reading numbers written with a format.
+// It tests:
+// - Currency, percent and accounting texts in the currency, percent and numfmt fields
+// - Texts which are not numbers
+// - Numbers read from their raw value whatever their number format
+func TestFormattedNumbersRead(t *testing.T) {
+	type Account struct {
+		Label   string  `excel:"Label"`
+		Amount  float64 `excel:"Amount,currency:USD"`
+		Rate    float64 `excel:"Rate,percent"`
+		Balance float64 `excel:"Balance,locale:fr-FR"`
+		Custom  float64 `excel:"Custom,numfmt:number"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Label", "Amount", "Rate", "Balance", "Custom"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Text", "$1,200.50", "12%", "-1 234,50 €", "EUR 3"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Accounting", "($45.00)", "12.5 %", "(12,00 €)", "USD -7"})
+	_ = file.SetSheetRow(sheet, "A4", &[]any{"Formatted", 1200.5, 0.12, -1234.5, 0.5})
+	percent, _ := file.NewStyle(&excelize.Style{NumFmt: 10})
+	currency := `"$"#,##0.00`
+	money, _ := file.NewStyle(&excelize.Style{CustomNumFmt: &currency})
+	fraction, _ := file.NewStyle(&excelize.Style{NumFmt: 12})
+	_ = file.SetCellStyle(sheet, "B4", "B4", money)
+	_ = file.SetCellStyle(sheet, "C4", "C4", percent)
+	_ = file.SetCellStyle(sheet, "D4", "D4", money)
+	_ = file.SetCellStyle(sheet, "E4", "E4", fraction)
+
+	xl, _ := NewReader(file, Strict())
+
+	var accounts []Account
+	err := xl.Unmarshal(&accounts)
+	assert.NoError(t, err)
+	assert.Equal(t, []Account{
+		{Label: "Text", Amount: 1200.5, Rate: 0.12, Balance: -1234.5, Custom: 3},
+		{Label: "Accounting", Amount: -45, Rate: 0.125, Balance: -12, Custom: -7},
+		{Label: "Formatted", Amount: 1200.5, Rate: 0.12, Balance: -1234.5, Custom: 0.5},
+	}, accounts)
+
+	// Units, letters and misplaced group separators are not numbers
+	for _, text := range []string{"12 kg", "v2", "1,5", "1.234,56", "EURO 3"} {
+		_ = file.SetCellValue(sheet, "E2", text)
+		err = xl.Unmarshal(&accounts)
+		assert.ErrorIs(t, err, ErrCellNotValid, text)
+	}
+
+	// Formatted text is only parsed in the numfmt, currency and percent fields
+	type Plain struct {
+		Amount float64 `excel:"Amount"`
+		Rate   float64 `excel:"Rate"`
+	}
+	var plain []Plain
+	xl, _ = NewReader(file, WithCellErrors(), WithMaxRows(1))
+	err = xl.Unmarshal(&plain)
+	assert.ErrorContains(t, err, "Sheet1!B2")
+	assert.ErrorContains(t, err, "Sheet1!C2")
+}
+
+// TestNullableRead verifies reading nullable fields.
+// It tests:
+// - sql.Null types and Optional values
+// - Empty cells read as invalid values
+// - Invalid cells in strict mode
+func TestNullableRead(t *testing.T) {
+	type Customer struct {
+		Name    string             `excel:"Name"`
+		Email   sql.NullString     `excel:"Email"`
+		Orders  sql.NullInt64      `excel:"Orders,locale:fr-FR"`
+		Since   sql.NullTime       `excel:"Since,format:02/01/2006"`
+		Premium Optional[bool]     `excel:"Premium,bool:Oui:true|Non:false"`
+		Score   *Optional[float64] `excel:"Score"`
+		Tags    Optional[[]string] `excel:"Tags,split:;"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Email", "Orders", "Since", "Premium", "Score", "Tags"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Alice", "alice@example.com", "1 200", "15/03/2021", "Oui", "4.5", "a;b"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Bob", "", "", "", "", "", ""})
+
+	xl, _ := NewReader(file)
+
+	var customers []Customer
+	err := xl.Unmarshal(&customers)
+	assert.NoError(t, err)
+	assert.Len(t, customers, 2)
+
+	alice := customers[0]
+	assert.Equal(t, sql.NullString{String: "alice@example.com", Valid: true}, alice.Email)
+	assert.Equal(t, sql.NullInt64{Int64: 1200, Valid: true}, alice.Orders)
+	assert.Equal(t, sql.NullTime{Time: time.Date(2021, 3, 15, 0, 0, 0, 0, time.Local), Valid: true}, alice.Since)
+	assert.Equal(t, Optional[bool]{Value: true, Valid: true}, alice.Premium)
+	assert.Equal(t, &Optional[float64]{Value: 4.5, Valid: true}, alice.Score)
+	assert.Equal(t, Optional[[]string]{Value: []string{"a", "b"}, Valid: true}, alice.Tags)
+
+	bob := customers[1]
+	assert.False(t, bob.Email.Valid)
+	assert.False(t, bob.Orders.Valid)
+	assert.False(t, bob.Since.Valid)
+	assert.False(t, bob.Premium.Valid)
+	assert.False(t, bob.Score != nil && bob.Score.Valid)
+	assert.False(t, bob.Tags.Valid)
+
+	// Invalid cells are reported in strict mode
+	_ = file.SetCellValue(sheet, "C3", "many")
+	xl, _ = NewReader(file, Strict())
+	err = xl.Unmarshal(&customers)
+	assert.ErrorIs(t, err, ErrCellNotValid)
+}
+
+// TestExactRead verifies reading numbers without losing precision.
+// It tests:
+// - Durations, big numbers and decimals with the precision tag
+// - Long integers
+// - Invalid durations in strict mode
+func TestExactRead(t *testing.T) {
+	type Payment struct {
+		Label    string        `excel:"Label"`
+		Duration time.Duration `excel:"Duration"`
+		ID       *big.Int      `excel:"ID"`
+		Amount   *big.Float    `excel:"Amount,precision:2"`
+		Price    string        `excel:"Price,precision:2"`
+		Rate     float64       `excel:"Rate,precision:2"`
+		Ref      int64         `excel:"Ref"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Label", "Duration", "ID", "Amount", "Price", "Rate", "Ref"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Go", "1h30m", "123456789012345678901234", "12345678901234567890.125", "1,234.5", "2.345", "1,234,567,890,123,456,789"})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Excel", "01:30:00", 42, 0.5, 1234.5, 0.1, 12})
+	_ = file.SetSheetRow(sheet, "A4", &[]any{"Fraction", 0.0625, "", "", "", "", ""})
+
+	// The formats of the cells don't round the exact numbers
+	style, _ := file.NewStyle(&excelize.Style{NumFmt: 1})
+	_ = file.SetCellStyle(sheet, "D3", "E3", style)
+	_ = file.SetCellStyle(sheet, "B4", "B4", style)
+
+	xl, _ := NewReader(file)
+
+	var payments []Payment
+	err := xl.Unmarshal(&payments)
+	assert.NoError(t, err)
+	assert.Len(t, payments, 3)
+
+	id, _ := new(big.Int).SetString("123456789012345678901234", 10)
+	assert.Equal(t, 90*time.Minute, payments[0].Duration)
+	assert.Equal(t, 0, id.Cmp(payments[0].ID))
+	assert.Equal(t, "12345678901234567890.13", payments[0].Amount.Text('f', 2))
+	assert.Equal(t, "1234.50", payments[0].Price)
+	assert.Equal(t, 2.35, payments[0].Rate)
+	assert.Equal(t, int64(1234567890123456789), payments[0].Ref)
+
+	assert.Equal(t, 90*time.Minute, payments[1].Duration)
+	assert.Equal(t, int64(42), payments[1].ID.Int64())
+	assert.Equal(t, "0.5", payments[1].Amount.Text('f', -1))
+	assert.Equal(t, "1234.50", payments[1].Price)
+	assert.Equal(t, 0.1, payments[1].Rate)
+
+	assert.Equal(t, 90*time.Minute, payments[2].Duration)
+	assert.Nil(t, payments[2].ID)
+
+	// Invalid durations are reported in strict mode
+	_ = file.SetCellValue(sheet, "B3", "soon")
+	xl, _ = NewReader(file, Strict())
+	err = xl.Unmarshal(&payments)
+	assert.ErrorIs(t, err, ErrCellNotValid)
+}
+
+// TestMapAndRepeatRead verifies reading maps and repeated columns.
+// It tests:
+// - Maps in one cell with the split and kvsep tags
+// - Slices spread across repeated columns, empty positions included
+// - Invalid pairs and elements in strict mode
+// - Empty keys with WithCellErrors
+func TestMapAndRepeatRead(t *testing.T) {
+	type Contact struct {
+		Name   string             `excel:"Name"`
+		Attrs  map[string]string  `excel:"Attrs"`
+		Scores map[string]float64 `excel:"Scores,split:|,kvsep::"`
+		Phones []string           `excel:"repeat:Phone {n}"`
+		Ages   []int              `excel:"repeat:Age ({n})"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	sheet := file.GetSheetName(file.GetActiveSheetIndex())
+	_ = file.SetSheetRow(sheet, "A1", &[]any{"Name", "Phone 2", "Attrs", "Phone 1", "Scores", "Age (1)", "Phone 3"})
+	_ = file.SetSheetRow(sheet, "A2", &[]any{"Alice", "0601", "team=blue; role = admin", "0102", "math:12.5|art:9", 31, ""})
+	_ = file.SetSheetRow(sheet, "A3", &[]any{"Bob", "", "", "", "", "", "0903"})
+
+	xl, _ := NewReader(file, Strict())
+
+	var contacts []Contact
+	err := xl.Unmarshal(&contacts)
+	assert.NoError(t, err)
+	assert.Equal(t, []Contact{
+		{
+			Name:   "Alice",
+			Attrs:  map[string]string{"team": "blue", "role": "admin"},
+			Scores: map[string]float64{"math": 12.5, "art": 9},
+			Phones: []string{"0102", "0601"},
+			Ages:   []int{31},
+		},
+		// The empty positions before the last value are kept
+		{Name: "Bob", Attrs: map[string]string{}, Scores: map[string]float64{}, Phones: []string{"", "", "0903"}, Ages: []int{}},
+	}, contacts)
+
+	// Pairs without separator and invalid elements are reported in strict mode
+	_ = file.SetCellValue(sheet, "C3", "team")
+	_ = file.SetCellValue(sheet, "F3", "old")
+	err = xl.Unmarshal(&contacts)
+	assert.ErrorIs(t, err, ErrCellNotValid)
+	assert.ErrorContains(t, err, "Sheet1!C3")
+	assert.ErrorContains(t, err, "Sheet1!F3")
+
+	// Empty keys are not valid
+	type Counts struct {
+		Name   string         `excel:"Name"`
+		Counts map[string]int `excel:"Attrs"`
+	}
+	var counts []Counts
+	_ = file.SetCellValue(sheet, "C3", "=1;b=2")
+	xl, _ = NewReader(file, WithCellErrors())
+	err = xl.Unmarshal(&counts)
+	assert.ErrorIs(t, err, ErrCellNotValid)
+	assert.ErrorContains(t, err, "Sheet1!C3")
+}
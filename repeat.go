@@ -0,0 +1,278 @@
+package excel
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// repeatPlaceholder is replaced by the number of the column in the title of the repeated columns
+const repeatPlaceholder = "{n}"
+
+var (
+	marshallerType   = reflect.TypeOf((*Marshaller)(nil)).Elem()
+	unmarshallerType = reflect.TypeOf((*Unmarshaller)(nil)).Elem()
+)
+
+// repeatFields returns the slice fields spread across repeated columns
+func (s *Struct) repeatFields(read bool) ([]*Field, error) {
+	var fields []*Field
+	for _, f := range s.Fields {
+		if f == nil {
+			continue
+		}
+		title := f.GetWriteRepeat()
+		if read {
+			title = f.GetReadRepeat()
+		}
+		if len(title) == 0 {
+			continue
+		}
+		if f.Type.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("excel: repeat field '%s' must be a slice, got %v", f.Name, f.Type)
+		}
+		if !strings.Contains(title, repeatPlaceholder) {
+			return nil, fmt.Errorf("excel: repeat title '%s' of field '%s' has no %s placeholder", title, f.Name, repeatPlaceholder)
+		}
+		if !f.isCellElement(f.Type.Elem(), read) {
+			return nil, fmt.Errorf("excel: repeat field '%s' has struct elements %v which can't be held in a cell: "+
+				"they must implement Marshaller and Unmarshaller or be encoded (ie: encoding:json)", f.Name, f.Type.Elem())
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// isCellElement returns true if an element of a repeat field can be held in one cell.
+// Structs must be encoded, implement Unmarshaller when reading and Marshaller when writing,
+// or be times, big numbers or nullable values.
+func (f *Field) isCellElement(t reflect.Type, read bool) bool {
+	base := indirectType(t)
+	if base.Kind() != reflect.Struct {
+		return true
+	}
+	encoding := f.GetWriteEncoding()
+	if read {
+		encoding = f.GetReadEncoding()
+	}
+	switch {
+	case len(encoding) > 0, base == timeType, t == bigIntType, t == bigFloatType, isNullableType(base):
+		return true
+	case read:
+		return reflect.PointerTo(base).Implements(unmarshallerType)
+	}
+	return reflect.PointerTo(base).Implements(marshallerType)
+}
+
+// repeatTitle returns the title of a repeated column (ie: Phone 2)
+func repeatTitle(title string, n int) string {
+	return strings.ReplaceAll(title, repeatPlaceholder, strconv.Itoa(n))
+}
+
+// repeatNumber returns the number of a repeated column from its title
+func repeatNumber(title string, cell string) (int, bool) {
+	prefix, suffix, _ := strings.Cut(title, repeatPlaceholder)
+	s, ok := strings.CutPrefix(cell, prefix)
+	if !ok {
+		return 0, false
+	}
+	if s, ok = strings.CutSuffix(s, suffix); !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n > 0 && strconv.Itoa(n) == s
+}
+
+// isRepeatTitle returns true if the title is a repeated column of a field
+func (r *StructReader) isRepeatTitle(cell string) bool {
+	for _, f := range r.repeatFields {
+		if _, ok := repeatNumber(f.GetReadRepeat(), cell); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// updateRepeatColumns finds the repeated columns of the fields, sorted by number
+func (r *StructReader) updateRepeatColumns() {
+	r.repeatColumns = make(map[*Field][]int, len(r.repeatFields))
+	for _, f := range r.repeatFields {
+		numbers := make(map[int]int)
+		for index, title := range r.titles {
+			if n, ok := repeatNumber(f.GetReadRepeat(), title); ok {
+				if _, found := numbers[n]; !found {
+					numbers[n] = index
+				}
+			}
+		}
+		keys := make([]int, 0, len(numbers))
+		for n := range numbers {
+			keys = append(keys, n)
+		}
+		slices.Sort(keys)
+		for _, n := range keys {
+			r.repeatColumns[f] = append(r.repeatColumns[f], numbers[n])
+		}
+	}
+}
+
+// readRepeat fills the slice fields with the values of their repeated columns.
+// Empty and invalid cells are read as zero values, so that the elements stay aligned
+// with their columns; the empty cells after the last value are dropped.
+// The invalid cells are returned with WithCellErrors.
+func (r *StructReader) readRepeat(container reflect.Value, row []string, rowNum int) (invalid []error, err error) {
+	for _, f := range r.repeatFields {
+		columns := r.repeatColumns[f]
+		elemType := f.Type.Elem()
+		slice := reflect.MakeSlice(f.Type, 0, len(columns))
+		length := 0
+		for _, index := range columns {
+			slice = reflect.Append(slice, reflect.Zero(elemType))
+			if index >= len(row) || len(row[index]) == 0 {
+				continue
+			}
+			length = slice.Len()
+
+			// The element is read as a field in the column
+			elem := f.withType(elemType)
+			tags := *f.ReadTags
+			tags.index = index
+			elem.ReadTags = &tags
+
+			value, err := r.readField(elem, row, rowNum)
+			if errors.Is(err, ErrCellNotValid) {
+				invalid = append(invalid, err)
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if value.IsValid() && value.Type().ConvertibleTo(elemType) {
+				slice.Index(slice.Len() - 1).Set(value.Convert(elemType))
+			}
+		}
+		if err := r.container.assign(container, f.Index, slice.Slice(0, length)); err != nil {
+			return nil, err
+		}
+	}
+	return invalid, nil
+}
+
+// usedColumns returns the columns used by the fields and the repeated columns,
+// and the next free column
+func (w *StructWriter) usedColumns() (map[int]bool, int) {
+	next := 0
+	used := make(map[int]bool)
+	for _, writer := range []*StructWriter{w, w.children} {
+		if writer == nil {
+			continue
+		}
+		for _, f := range writer.Struct.Fields {
+			if f != nil && !f.GetWriteIgnore() && f.WriteTags.index >= 0 {
+				used[f.WriteTags.index] = true
+				next = max(next, f.WriteTags.index+1)
+			}
+		}
+	}
+	for _, columns := range w.repeatColumns {
+		for _, index := range columns {
+			used[index] = true
+			next = max(next, index+1)
+		}
+	}
+	return used, next
+}
+
+// updateRepeatColumns sets the repeated columns of the fields.
+// A field has as many columns as the longest slice of the elements.
+// Titles which are not found in the title row are written after the other columns.
+func (w *StructWriter) updateRepeatColumns(row []string, data any) {
+	w.repeatColumns = nil
+	if len(w.repeatFields) == 0 {
+		return
+	}
+
+	// Longest slice of each field
+	counts := make(map[*Field]int, len(w.repeatFields))
+	s := reflect.Indirect(reflect.ValueOf(data))
+	for i := 0; i < s.Len(); i++ {
+		values := reflect.Indirect(s.Index(i))
+		if !values.IsValid() {
+			continue
+		}
+		for _, f := range w.repeatFields {
+			if slice, err := w.container.findFieldByIndex(values, f.Index); err == nil {
+				counts[f] = max(counts[f], slice.Len())
+			}
+		}
+	}
+
+	w.repeatColumns = make(map[*Field][]int, len(w.repeatFields))
+	for _, f := range w.repeatFields {
+		used, next := w.usedColumns()
+		for n := 1; n <= counts[f]; n++ {
+			index := slices.Index(row, repeatTitle(f.GetWriteRepeat(), n))
+			if index < 0 || used[index] {
+				index = next
+			}
+			w.repeatColumns[f] = append(w.repeatColumns[f], index)
+			used[index] = true
+			next = max(next, index+1)
+		}
+	}
+}
+
+// writeRepeatTitles writes the titles of the repeated columns
+func (w *StructWriter) writeRepeatTitles(col, row int) error {
+	for f, columns := range w.repeatColumns {
+		for i, index := range columns {
+			cell, err := excelize.CoordinatesToCellName(col+index, row)
+			if err != nil {
+				return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+			}
+			title := repeatTitle(f.GetWriteRepeat(), i+1)
+			if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, title); err != nil {
+				return fmt.Errorf("excel: failed to set cell value for title at %s: %w", cell, err)
+			}
+			w.Writer.track(col+index, row, title)
+		}
+	}
+	return nil
+}
+
+// writeRepeat writes the elements of the slice fields in their repeated columns
+func (w *StructWriter) writeRepeat(values reflect.Value, col, row int) error {
+	for f, columns := range w.repeatColumns {
+		slice, err := w.container.findFieldByIndex(values, f.Index)
+		if err != nil {
+			return fmt.Errorf("excel: failed to find field at index %d: %w", f.Index, err)
+		}
+		elem := f.withType(f.Type.Elem())
+		for i := 0; i < slice.Len() && i < len(columns); i++ {
+			cell, err := excelize.CoordinatesToCellName(col+columns[i], row)
+			if err != nil {
+				return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+			}
+			if err = w.writeCell(elem, cell, row, slice.Index(i)); err != nil {
+				return &CellError{
+					Sheet: w.Writer.Sheet.Name,
+					Cell:  cell,
+					Row:   row,
+					Col:   col + columns[i],
+					Value: fmt.Sprint(slice.Index(i).Interface()),
+					Type:  elem.Type.String(),
+					Err:   fmt.Errorf("%w: %w", ErrCellWrite, err),
+				}
+			}
+			if err = w.writeNumFmt(elem, cell); err != nil {
+				return fmt.Errorf("excel: failed to set number format at %s: %w", cell, err)
+			}
+		}
+	}
+	return nil
+}
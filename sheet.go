@@ -1,11 +1,18 @@
 package excel
 
-import "github.com/xuri/excelize/v2"
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
 
 // Sheet represent the sheet in the
 // Excel file where data will read or write
 type Sheet struct {
 	file  *excelize.File
+	excel *Excel
 	Name  string
 	Index int
 }
@@ -30,8 +37,9 @@ func (e *Excel) GetSheet(name string) *Sheet {
 		return nil
 	}
 	sheet := &Sheet{
-		file: e.File,
-		Name: name,
+		file:  e.File,
+		excel: e,
+		Name:  name,
 	}
 	// Get the sheet index
 	index, err := e.File.GetSheetIndex(name)
@@ -49,7 +57,8 @@ func (e *Excel) GetSheetFromIndex(index int) *Sheet {
 		return nil
 	}
 	sheet := &Sheet{
-		file: e.File,
+		file:  e.File,
+		excel: e,
 	}
 	// Get the sheet name
 	sheet.Name = e.File.GetSheetName(index)
@@ -65,7 +74,8 @@ func (e *Excel) GetActiveSheet() *Sheet {
 		return nil
 	}
 	sheet := &Sheet{
-		file: e.File,
+		file:  e.File,
+		excel: e,
 	}
 	// Get the sheet index
 	sheet.Index = e.File.GetActiveSheetIndex()
@@ -158,3 +168,266 @@ func (s *Sheet) GetComment(cell string) *excelize.Comment {
 	}
 	return nil
 }
+
+// Sheets returns an iterator over the sheets of the file
+func (e *Excel) Sheets() iter.Seq[*Sheet] {
+	return func(yield func(*Sheet) bool) {
+		if e.File == nil {
+			return
+		}
+		for index, name := range e.File.GetSheetList() {
+			if !yield(&Sheet{file: e.File, excel: e, Name: name, Index: index}) {
+				return
+			}
+		}
+	}
+}
+
+// NewSheet creates a new sheet and returns it.
+// ErrSheetExists is returned if a sheet with the same name already exists.
+func (e *Excel) NewSheet(name string) (*Sheet, error) {
+	if e.File == nil {
+		return nil, ErrFileIsNil
+	}
+	if name == "" {
+		return nil, ErrSheetNameEmpty
+	}
+	if index, err := e.File.GetSheetIndex(name); err != nil {
+		return nil, err
+	} else if index >= 0 {
+		return nil, ErrSheetExists
+	}
+	index, err := e.File.NewSheet(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Sheet{file: e.File, excel: e, Name: name, Index: index}, nil
+}
+
+// CopySheet copies the content of a sheet into a new sheet and returns it.
+// ErrSheetExists is returned if the target sheet already exists.
+func (e *Excel) CopySheet(from string, to string) (*Sheet, error) {
+	source, err := e.findSheet(from)
+	if err != nil {
+		return nil, err
+	}
+	target, err := e.NewSheet(to)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.File.CopySheet(source.Index, target.Index); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// DeleteSheet deletes a sheet.
+// The last sheet of a file can't be deleted.
+func (e *Excel) DeleteSheet(name string) error {
+	if _, err := e.findSheet(name); err != nil {
+		return err
+	}
+	if err := e.File.DeleteSheet(name); err != nil {
+		return err
+	}
+	e.updateSheetIndexes()
+	return nil
+}
+
+// MoveSheet moves a sheet to the given 0-based position.
+// The names local to the sheets and the active sheet follow their sheet,
+// and the sheet used by the reader or writer keeps its name with its new index.
+func (e *Excel) MoveSheet(name string, index int) error {
+	sheet, err := e.findSheet(name)
+	if err != nil {
+		return err
+	}
+	count := len(e.File.GetSheetList())
+	if index < 0 || index >= count {
+		return ErrSheetIndex
+	}
+	if index == sheet.Index {
+		return nil
+	}
+
+	wb := e.File.WorkBook
+	active := e.File.GetSheetName(e.File.GetActiveSheetIndex())
+
+	// Move the sheet by swapping it with its neighbours
+	sheets := wb.Sheets.Sheet
+	step := 1
+	if index < sheet.Index {
+		step = -1
+	}
+	for i := sheet.Index; i != index; i += step {
+		sheets[i], sheets[i+step] = sheets[i+step], sheets[i]
+	}
+
+	// The names local to a sheet follow their sheet
+	if wb.DefinedNames != nil {
+		for _, name := range wb.DefinedNames.DefinedName {
+			if name.LocalSheetID == nil {
+				continue
+			}
+			id := *name.LocalSheetID
+			switch {
+			case id == sheet.Index:
+				id = index
+			case step > 0 && id > sheet.Index && id <= index:
+				id--
+			case step < 0 && id >= index && id < sheet.Index:
+				id++
+			}
+			*name.LocalSheetID = id
+		}
+	}
+
+	// Keep the same active sheet
+	position, err := e.File.GetSheetIndex(active)
+	if err != nil {
+		return err
+	}
+	e.File.SetActiveSheet(position)
+	e.updateSheetIndexes()
+	return nil
+}
+
+// currentSheets returns the sheets used by the reader and the writer
+func (e *Excel) currentSheets() []*Sheet {
+	var sheets []*Sheet
+	if e.Reader != nil {
+		sheets = append(sheets, &e.Reader.Sheet)
+	}
+	if e.Writer != nil {
+		sheets = append(sheets, &e.Writer.Sheet)
+	}
+	return sheets
+}
+
+// updateSheetIndexes updates the index of the sheets used by the reader and the writer
+// after the sheets of the file have been moved or deleted
+func (e *Excel) updateSheetIndexes() {
+	for _, sheet := range e.currentSheets() {
+		if index, err := e.File.GetSheetIndex(sheet.Name); err == nil {
+			sheet.Index = index
+		}
+	}
+}
+
+// findSheet returns the sheet with the given name
+func (e *Excel) findSheet(name string) (*Sheet, error) {
+	if e.File == nil {
+		return nil, ErrFileIsNil
+	}
+	if name == "" {
+		return nil, ErrSheetNameEmpty
+	}
+	index, err := e.File.GetSheetIndex(name)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 {
+		return nil, ErrSheetNotFound
+	}
+	return &Sheet{file: e.File, excel: e, Name: e.File.GetSheetName(index), Index: index}, nil
+}
+
+// Rename renames the sheet.
+// The sheet used by the reader or writer of the Excel which returned the sheet
+// is renamed too when it is the same sheet.
+func (s *Sheet) Rename(name string) error {
+	if err := s.IsValidError(); err != nil {
+		return err
+	}
+	if name == "" {
+		return ErrSheetNameEmpty
+	}
+	if index, err := s.file.GetSheetIndex(s.Name); err != nil {
+		return err
+	} else if index < 0 {
+		return ErrSheetNotFound
+	}
+	if err := s.file.SetSheetName(s.Name, name); err != nil {
+		return err
+	}
+	if s.excel != nil {
+		for _, sheet := range s.excel.currentSheets() {
+			if sheet != s && sheet.Name == s.Name {
+				sheet.Name = name
+			}
+		}
+	}
+	s.Name = name
+	return nil
+}
+
+// Dimension returns the used range of the sheet,
+// from the first to the last non-empty cell
+func (s *Sheet) Dimension() (*Range, error) {
+	if err := s.IsValidError(); err != nil {
+		return nil, err
+	}
+	rows, err := s.file.GetRows(s.Name)
+	if err != nil {
+		return nil, err
+	}
+	rng := &Range{}
+	for r, row := range rows {
+		for c, value := range row {
+			if value == "" {
+				continue
+			}
+			if rng.StartRow == 0 || r+1 < rng.StartRow {
+				rng.StartRow = r + 1
+			}
+			if rng.StartColumn == 0 || c+1 < rng.StartColumn {
+				rng.StartColumn = c + 1
+			}
+			rng.EndRow = max(rng.EndRow, r+1)
+			rng.EndColumn = max(rng.EndColumn, c+1)
+		}
+	}
+	// An empty sheet only uses its first cell
+	if rng.StartRow == 0 {
+		return MinRange("A1")
+	}
+	return rng, rng.UpdateNames()
+}
+
+// Clear removes the values and the formulas of the cells of a range (ie: A2:D10)
+func (s *Sheet) Clear(ref string) error {
+	if err := s.IsValidError(); err != nil {
+		return err
+	}
+	rng, err := ToRange(ref)
+	if err != nil {
+		return err
+	}
+	for row := rng.StartRow; row <= rng.EndRow; row++ {
+		for col := rng.StartColumn; col <= rng.EndColumn; col++ {
+			cell, err := excelize.CoordinatesToCellName(col, row)
+			if err != nil {
+				return err
+			}
+			if err := s.file.SetCellFormula(s.Name, cell, ""); err != nil {
+				return &CellError{Sheet: s.Name, Cell: cell, Row: row, Col: col, Err: fmt.Errorf("%w: %w", ErrCellWrite, err)}
+			}
+			if err := s.file.SetCellValue(s.Name, cell, nil); err != nil {
+				return &CellError{Sheet: s.Name, Cell: cell, Row: row, Col: col, Err: fmt.Errorf("%w: %w", ErrCellWrite, err)}
+			}
+		}
+	}
+	return nil
+}
+
+// SetTabColor sets the color of the tab of the sheet (ie: #FF0000)
+func (s *Sheet) SetTabColor(color string) error {
+	if err := s.IsValidError(); err != nil {
+		return err
+	}
+	rgb := strings.ToUpper(strings.TrimPrefix(color, "#"))
+	if len(rgb) == 6 {
+		rgb = "FF" + rgb
+	}
+	return s.file.SetSheetProps(s.Name, &excelize.SheetPropsOptions{TabColorRGB: &rgb})
+}
@@ -0,0 +1,139 @@
+package excel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+// TestSheetVisibility verifies reading and changing the visibility of a sheet.
+// It tests:
+// - Visible, hidden and very hidden sheets
+// - Invalid visibilities and sheets without file
+func TestSheetVisibility(t *testing.T) {
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+	_, err := file.NewSheet("Lookup")
+	assert.NoError(t, err)
+
+	xls, _ := NewWriter(file)
+	sheet := xls.GetSheet("Lookup")
+
+	visibility, err := sheet.Visibility()
+	assert.NoError(t, err)
+	assert.Equal(t, SheetVisible, visibility)
+
+	assert.NoError(t, sheet.Hide())
+	visibility, _ = sheet.Visibility()
+	assert.Equal(t, SheetHidden, visibility)
+
+	assert.NoError(t, sheet.SetVisibility(SheetVeryHidden))
+	visibility, _ = sheet.Visibility()
+	assert.Equal(t, SheetVeryHidden, visibility)
+
+	assert.NoError(t, sheet.Show())
+	visibility, _ = sheet.Visibility()
+	assert.Equal(t, SheetVisible, visibility)
+
+	assert.ErrorIs(t, sheet.SetVisibility("unknown"), ErrSheetVisibility)
+	assert.ErrorIs(t, (&Sheet{}).Hide(), ErrFileIsNil)
+}
+
+// TestSheetManagement verifies the management of the sheets of a file.
+// It tests:
+// - Creating, copying, clearing and deleting sheets
+// - Used range, active sheet, moving, renaming and tab color
+func TestSheetManagement(t *testing.T) {
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xls, _ := NewWriter(file)
+
+	// New sheets
+	orders, err := xls.NewSheet("Orders")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, orders.Index)
+	_, err = xls.NewSheet("")
+	assert.ErrorIs(t, err, ErrSheetNameEmpty)
+	_, err = xls.NewSheet("Orders")
+	assert.ErrorIs(t, err, ErrSheetExists)
+
+	// Content and used range
+	assert.NoError(t, file.SetCellValue("Orders", "B2", "Id"))
+	assert.NoError(t, file.SetCellValue("Orders", "D5", 12))
+	dim, err := orders.Dimension()
+	assert.NoError(t, err)
+	assert.Equal(t, "B2:D5", dim.ToRef())
+
+	// Copy
+	archive, err := xls.CopySheet("Orders", "Archive")
+	assert.NoError(t, err)
+	value, _ := file.GetCellValue(archive.Name, "D5")
+	assert.Equal(t, "12", value)
+	_, err = xls.CopySheet("Unknown", "Copy")
+	assert.ErrorIs(t, err, ErrSheetNotFound)
+	_, err = xls.CopySheet("Archive", "Orders")
+	assert.ErrorIs(t, err, ErrSheetExists)
+	value, _ = file.GetCellValue("Orders", "B2")
+	assert.Equal(t, "Id", value)
+
+	// Clear
+	assert.NoError(t, archive.Clear("C3:D5"))
+	value, _ = file.GetCellValue(archive.Name, "D5")
+	assert.Equal(t, "", value)
+	dim, _ = archive.Dimension()
+	assert.Equal(t, "B2:B2", dim.ToRef())
+
+	// Active sheet
+	xls.SetActiveSheet(orders)
+	assert.Equal(t, "Orders", xls.GetActiveSheet().Name)
+	var names []string
+	for sheet := range xls.Sheets() {
+		names = append(names, sheet.Name)
+	}
+	assert.Equal(t, []string{"Sheet1", "Orders", "Archive"}, names)
+
+	// Move
+	assert.NoError(t, file.SetDefinedName(&excelize.DefinedName{Name: "Ids", RefersTo: "Archive!$B$2", Scope: "Archive"}))
+	assert.NoError(t, xls.MoveSheet("Archive", 0))
+	names = nil
+	for sheet := range xls.Sheets() {
+		names = append(names, sheet.Name)
+	}
+	assert.Equal(t, []string{"Archive", "Sheet1", "Orders"}, names)
+	assert.Equal(t, "Orders", xls.GetActiveSheet().Name)
+	assert.Equal(t, 2, xls.Sheet().Index)
+	definedNames := file.GetDefinedName()
+	assert.Len(t, definedNames, 1)
+	assert.Equal(t, "Archive", definedNames[0].Scope)
+	assert.ErrorIs(t, xls.MoveSheet("Archive", 3), ErrSheetIndex)
+	assert.ErrorIs(t, xls.MoveSheet("Unknown", 0), ErrSheetNotFound)
+
+	// Rename
+	assert.NoError(t, archive.Rename("History"))
+	assert.Equal(t, "History", archive.Name)
+	assert.NotNil(t, xls.GetSheet("History"))
+	assert.ErrorIs(t, archive.Rename(""), ErrSheetNameEmpty)
+	assert.ErrorIs(t, (&Sheet{file: file, Name: "Unknown"}).Rename("Other"), ErrSheetNotFound)
+
+	// Renaming a copy of the current sheet renames the sheet of the writer
+	assert.NoError(t, xls.GetSheet("Orders").Rename("Sales"))
+	assert.Equal(t, "Sales", xls.Sheet().Name)
+	assert.NoError(t, xls.Marshal(&[]Named{{ID: 1, Name: "One"}}))
+	value, _ = file.GetCellValue("Sales", "B2")
+	assert.Equal(t, "One", value)
+
+	// Tab color
+	assert.NoError(t, xls.Sheet().SetTabColor("#ff0000"))
+	props, err := file.GetSheetProps("Sales")
+	assert.NoError(t, err)
+	assert.Equal(t, "FFFF0000", *props.TabColorRGB)
+
+	// Delete
+	assert.NoError(t, xls.DeleteSheet("History"))
+	assert.Equal(t, []string{"Sheet1", "Sales"}, file.GetSheetList())
+	assert.Equal(t, 1, xls.Sheet().Index)
+	assert.ErrorIs(t, xls.DeleteSheet("History"), ErrSheetNotFound)
+	assert.ErrorIs(t, xls.DeleteSheet(""), ErrSheetNameEmpty)
+}
@@ -0,0 +1,116 @@
+package excel
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Strict makes the struct reader check the title row before reading the data rows.
+// The reading fails with a SchemaError when:
+//   - a title is not mapped to any field, unless the struct has an extra field
+//   - the same title appears more than once
+//   - a field has no column, unless it is optional
+//
+// A field is optional when it is a pointer, has a default value or is tagged optional.
+// Strict also enables WithCellErrors.
+func Strict() Option {
+	return func(o *options) {
+		o.strict = true
+		o.cellErrors = true
+	}
+}
+
+// WithCellErrors reports the values which can't be converted to the type of their field,
+// which are otherwise left empty, as CellError. All the invalid rows are returned together
+// in a MultiError. It is enabled by Strict.
+func WithCellErrors() Option {
+	return func(o *options) {
+		o.cellErrors = true
+	}
+}
+
+// SchemaError lists all the problems found in the title row of a sheet in strict mode
+type SchemaError struct {
+	Sheet string
+	// Unknown are the titles not mapped to any field
+	Unknown []string
+	// Duplicates are the titles found more than once
+	Duplicates []string
+	// Missing are the columns of the fields which are not in the title row
+	Missing []string
+}
+
+// Error returns the description of all the problems
+func (e *SchemaError) Error() string {
+	var problems []string
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown columns "+strings.Join(e.Unknown, ", "))
+	}
+	if len(e.Duplicates) > 0 {
+		problems = append(problems, "duplicate columns "+strings.Join(e.Duplicates, ", "))
+	}
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing columns "+strings.Join(e.Missing, ", "))
+	}
+	return fmt.Sprintf("excel: the columns of sheet '%s' are not valid: %s", e.Sheet, strings.Join(problems, "; "))
+}
+
+// Unwrap returns ErrSchemaNotValid
+func (e *SchemaError) Unwrap() error {
+	return ErrSchemaNotValid
+}
+
+// isOptional returns true if the field may have no column when reading in strict mode
+func (f *Field) isOptional() bool {
+	return f.Type.Kind() == reflect.Pointer || f.isNullable(true) || f.GetReadDefault() != nil || f.GetReadOptional()
+}
+
+// checkSchema checks the title row in strict mode
+func (r *StructReader) checkSchema(row []string) error {
+	readers := []*StructReader{r}
+	if r.children != nil {
+		readers = append(readers, r.children)
+	}
+
+	schema := &SchemaError{Sheet: r.Reader.Sheet.Name}
+
+	// Titles of the fields
+	var columns []string
+	for _, reader := range readers {
+		for _, f := range reader.Struct.Fields {
+			if f == nil || f.GetReadIgnore() {
+				continue
+			}
+			name := f.GetReadColumnName()
+			columns = append(columns, name)
+			if !slices.Contains(row, name) && !f.isOptional() {
+				schema.Missing = append(schema.Missing, name)
+			}
+		}
+	}
+
+	// Titles of the sheet
+	seen := make(map[string]bool)
+	for _, title := range row {
+		if len(strings.TrimSpace(title)) == 0 {
+			continue
+		}
+		if seen[title] {
+			if !slices.Contains(schema.Duplicates, title) {
+				schema.Duplicates = append(schema.Duplicates, title)
+			}
+			continue
+		}
+		seen[title] = true
+		if r.extraField == nil && !slices.Contains(columns, title) && !r.isRepeatTitle(title) {
+			schema.Unknown = append(schema.Unknown, title)
+		}
+	}
+
+	if len(schema.Unknown) > 0 || len(schema.Duplicates) > 0 || len(schema.Missing) > 0 {
+		return schema
+	}
+	return nil
+}
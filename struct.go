@@ -52,6 +52,24 @@ func (s *Struct) parseTag(tag *tags.Tag) (t *Tags) {
 		return
 	}
 
+	if tag.Name == TagChildren || tag.GetOption(TagChildren) != nil {
+		t.Children = true
+		t.Ignore = true
+		return
+	}
+
+	if tag.GetOption(TagExtra) != nil {
+		t.Extra = true
+		t.Ignore = true
+		return
+	}
+
+	if o := tag.GetOption(TagMeta); o != nil {
+		t.Meta = convert.ToString(o.Value)
+		t.Ignore = true
+		return
+	}
+
 	if len(tag.Name) > 0 {
 		t.Column = tag.Name
 	}
@@ -76,6 +94,64 @@ func (s *Struct) parseTag(tag *tags.Tag) (t *Tags) {
 	if o := tag.GetOption(TagRequired); o != nil {
 		t.Required = true
 	}
+	if o := tag.GetOption(TagOptional); o != nil {
+		t.Optional = true
+	}
+	if o := tag.GetOption(TagLocale); o != nil {
+		t.Locale = LocaleDefault
+		if o.Value != nil {
+			t.Locale = convert.ToString(o.Value)
+		}
+	}
+	if o := tag.GetOption(TagBool); o != nil {
+		t.Bool = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagPercent); o != nil {
+		t.Percent = true
+	}
+	if o := tag.GetOption(TagKVSep); o != nil {
+		t.KVSep = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagRepeat); o != nil {
+		t.Repeat = convert.ToString(o.Value)
+		t.Ignore = true
+	}
+	if o := tag.GetOption(TagPrecision); o != nil {
+		t.Precision = convert.ToInt(o.Value)
+	}
+	if o := tag.GetOption(TagSource); o != nil {
+		t.Source = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagComment); o != nil {
+		t.CommentFrom = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagNumFmt); o != nil {
+		t.NumFmt = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagCurrency); o != nil {
+		t.Currency = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagWidth); o != nil {
+		t.Width = convert.ToFloat64(o.Value)
+	}
+	if o := tag.GetOption(TagCF); o != nil {
+		t.ConditionalFormat = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagTotal); o != nil {
+		t.Total = convert.ToString(o.Value)
+	}
+	if o := tag.GetOption(TagHidden); o != nil {
+		t.Hidden = true
+	}
+	if o := tag.GetOption(TagKey); o != nil {
+		t.Key = true
+	}
+	if o := tag.GetOption(TagFormula); o != nil {
+		t.Formula = true
+		if o.Value != nil {
+			t.FormulaTemplate = convert.ToString(o.Value)
+		}
+	}
 
 	return t
 }
@@ -91,8 +167,29 @@ func (s *Struct) freeze(from *Tags, to *Tags) {
 		to.Format = from.Format
 		to.Encoding = from.Encoding
 		to.Split = from.Split
+		to.KVSep = from.KVSep
 		to.Required = from.Required
 		to.Ignore = from.Ignore
+		to.Optional = from.Optional
+		to.Source = from.Source
+		to.Locale = from.Locale
+		to.Bool = from.Bool
+		to.Percent = from.Percent
+		to.Precision = from.Precision
+		to.CommentFrom = from.CommentFrom
+		to.Formula = from.Formula
+		to.FormulaTemplate = from.FormulaTemplate
+		to.NumFmt = from.NumFmt
+		to.Currency = from.Currency
+		to.Width = from.Width
+		to.Hidden = from.Hidden
+		to.ConditionalFormat = from.ConditionalFormat
+		to.Total = from.Total
+		to.Children = from.Children
+		to.Key = from.Key
+		to.Meta = from.Meta
+		to.Extra = from.Extra
+		to.Repeat = from.Repeat
 	}
 }
 
@@ -105,3 +202,13 @@ func (s *Struct) GetField(index int) *Field {
 	}
 	return nil
 }
+
+// GetFieldByName returns the field from its name
+func (s *Struct) GetFieldByName(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
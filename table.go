@@ -1,7 +1,7 @@
 package excel
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/xuri/excelize/v2"
 )
@@ -69,7 +69,7 @@ func (e *Excel) GetTable(name string) (*Table, error) {
 		}
 	}
 
-	return nil, errors.New("table not found")
+	return nil, fmt.Errorf("%w: '%s'", ErrTableNotFound, name)
 }
 
 // GetTableSheet returns the sheet where the table is located
@@ -89,7 +89,7 @@ func (e *Excel) AddTable(table *Table) error {
 	}
 	// Table must be set
 	if table == nil || table.Table == nil {
-		return errors.New("table is nil")
+		return ErrTableIsNil
 	}
 	if err := table.IsValidError(); err != nil {
 		return err
@@ -217,7 +217,9 @@ func (t *Table) DeleteContent() error {
 	for col := tRange.StartColumn; col <= tRange.EndColumn; col++ {
 		for row := tRange.StartRow + 1; row <= tRange.EndRow; row++ {
 			cell, _ := excelize.CoordinatesToCellName(col, row)
-			_ = t.Sheet.file.SetCellValue(t.Sheet.Name, cell, nil)
+			if err := t.Sheet.file.SetCellValue(t.Sheet.Name, cell, nil); err != nil {
+				return &CellError{Sheet: t.Sheet.Name, Cell: cell, Row: row, Col: col, Err: fmt.Errorf("%w: %w", ErrCellWrite, err)}
+			}
 		}
 	}
 
@@ -261,7 +263,8 @@ func (t *Table) GetDataRange() (*Range, error) {
 	return dRange, dRange.UpdateNames()
 }
 
-// GetColumn returns the column index of the title
+// GetColumn returns the column index of the title.
+// A ColumnError wrapping ErrColumnNotFound is returned if no column has the title.
 func (t *Table) GetColumn(title string) (int, error) {
 	hr, err := t.GetHeaderRange()
 	if err != nil {
@@ -280,7 +283,7 @@ func (t *Table) GetColumn(title string) (int, error) {
 			return col, nil
 		}
 	}
-	return 0, nil
+	return 0, &ColumnError{Column: title, Err: ErrColumnNotFound}
 }
 
 // GetColumnAt returns the column name at the desired index
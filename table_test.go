@@ -34,6 +34,21 @@ func TestGetTable(t *testing.T) {
 		assert.Equal(t, "Sheet1", table.Sheet.Name)
 	})
 
+	t.Run("GetColumn", func(t *testing.T) {
+		assert.NoError(t, xls.File.SetSheetRow("Sheet1", "A1", &[]string{"Id", "Name"}))
+		table, err := xls.GetTable("Table1")
+		assert.NoError(t, err)
+		col, err := table.GetColumn("Name")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, col)
+
+		_, err = table.GetColumn("Unknown")
+		assert.ErrorIs(t, err, ErrColumnNotFound)
+		var columnErr *ColumnError
+		assert.ErrorAs(t, err, &columnErr)
+		assert.Equal(t, "Unknown", columnErr.Column)
+	})
+
 	t.Run("GetTableSheet", func(t *testing.T) {
 		sheet, err := xls.GetTableSheet("Table1")
 		assert.NoError(t, err)
@@ -5,13 +5,42 @@ const (
 	TagKeyIn   = TagKeyMain + "-in"
 	TagKeyOut  = TagKeyMain + "-out"
 
-	TagColumn   = "column"
-	TagDefault  = "default"
-	TagFormat   = "format"
-	TagEncoding = "encoding"
-	TagSplit    = "split"
-	TagRequired = "required"
-	TagIgnore   = "-"
+	TagColumn    = "column"
+	TagDefault   = "default"
+	TagFormat    = "format"
+	TagEncoding  = "encoding"
+	TagSplit     = "split"
+	TagRequired  = "required"
+	TagSource    = "source"
+	TagFormula   = "formula"
+	TagComment   = "commentFrom"
+	TagWidth     = "width"
+	TagCF        = "cf"
+	TagTotal     = "total"
+	TagChildren  = "children"
+	TagKey       = "key"
+	TagHidden    = "hidden"
+	TagMeta      = "meta"
+	TagExtra     = "extra"
+	TagOptional  = "optional"
+	TagLocale    = "locale"
+	TagBool      = "bool"
+	TagPercent   = "percent"
+	TagNumFmt    = "numfmt"
+	TagCurrency  = "currency"
+	TagPrecision = "precision"
+	TagKVSep     = "kvsep"
+	TagRepeat    = "repeat"
+	TagIgnore    = "-"
+)
+
+// Sources of the value read from a cell, used with the source tag
+const (
+	SourceValue     = "value"     // the formatted value (default)
+	SourceRaw       = "raw"       // the raw unformatted value
+	SourceFormula   = "formula"   // the formula of the cell
+	SourceHyperlink = "hyperlink" // the target of the hyperlink of the cell
+	SourceComment   = "comment"   // the text of the comment of the cell
 )
 
 // Tags is used to store the mainTags parameters of a field.
@@ -37,8 +66,69 @@ type Tags struct {
 	Format   string
 	Encoding string
 	Split    string
+	// KVSep separates the keys and the values of the maps held in a cell
+	KVSep    string
 	Required bool
 	Ignore   bool
+	Source   string
+
+	// Optional allows the column to be missing when reading in strict mode
+	Optional bool
+
+	// Locale is the locale of the numbers (ie: fr-FR).
+	// When writing, the numbers are written as text with the conventions of the locale.
+	// LocaleDefault uses the locale given with the WithLocale option.
+	Locale string
+	// Bool are the labels of the booleans (ie: Oui:true|Non:false)
+	Bool string
+	// Percent reads "45 %" as 0.45 and writes localized numbers as percents
+	Percent bool
+	// Precision is the number of decimals of the exact decimals
+	// held in string, float and *big.Float fields
+	Precision int
+
+	// CommentFrom is the name of the field holding the comment of the cell
+	CommentFrom string
+
+	// Formula writes the value of the field as a formula
+	Formula bool
+	// FormulaTemplate is a formula written on each row instead of the value of the field.
+	// The {row} placeholder is replaced by the row number.
+	FormulaTemplate string
+
+	// NumFmt is the number format of the cells when writing: percent, integer, number,
+	// accounting or a custom number format.
+	// When reading, the numbers typed as formatted text (ie: (1,200.50)) are parsed.
+	NumFmt string
+	// Currency is the ISO 4217 code of the currency format of the cells when writing (ie: EUR).
+	// When reading, the amounts typed as text (ie: $1,200.50) are parsed.
+	Currency string
+
+	// Width is the width of the column when writing
+	Width float64
+	// Hidden hides the column when writing
+	Hidden bool
+	// ConditionalFormat are the conditional formats of the column when writing.
+	// See ParseConditionalFormats for the syntax.
+	ConditionalFormat string
+	// Total is the function of the totals row when writing: sum, avg, count, min or max
+	Total string
+
+	// Children marks the slice field holding the detail rows of a master-detail struct
+	Children bool
+	// Key marks the fields identifying the parent of the detail rows
+	Key bool
+
+	// Meta fills the field with metadata of the row instead of a column when reading.
+	// The supported values are MetaRow, MetaSheet, MetaRaw and MetaCell followed by a column name.
+	Meta string
+
+	// Extra marks the map field holding the columns which are not mapped to a field
+	Extra bool
+	// Repeat is the title of the repeated columns of a slice field (ie: Phone {n}).
+	// The {n} placeholder is replaced by the number of the column, starting at 1.
+	// Struct elements must implement Marshaller and Unmarshaller or be encoded (ie: encoding:json).
+	Repeat string
 
 	// internal
 	index int // The index of the column in the Excel file.
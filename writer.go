@@ -1,8 +1,11 @@
 package excel
 
 import (
+	"fmt"
 	"reflect"
+	"slices"
 
+	"github.com/go-mods/convert"
 	"github.com/xuri/excelize/v2"
 )
 
@@ -26,6 +29,16 @@ type Writer struct {
 	Sheet  Sheet
 	Axis   Axis
 	Result *WriterResult
+
+	opts   options
+	region region
+
+	// outlined is true once the outline properties of the sheet are set
+	outlined bool
+	// outline is the last row written with an outline level
+	outline outlineRow
+	// styles are the styles created by the writer, by key
+	styles map[string]int
 }
 
 // WriterResult contains information about the result of a write operation,
@@ -89,3 +102,43 @@ func (w *Writer) newWriter(container any) (IWriter, error) {
 		return nil, ErrNoWriterFound
 	}
 }
+
+// transformRow writes the values of a row changed by the row transformation
+// with the type of their original value
+func (w *Writer) transformRow(encoded []string, originals []any, col, row int) error {
+	transformed := w.opts.rowTransform(slices.Clone(encoded))
+	for index, value := range transformed {
+		if index < len(encoded) && encoded[index] == value {
+			continue
+		}
+		cell, err := excelize.CoordinatesToCellName(col+index, row)
+		if err != nil {
+			return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+		}
+		var original any
+		if index < len(originals) {
+			original = originals[index]
+		}
+		if err := w.setTransformed(cell, value, original); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setTransformed sets a value changed by the row transformation.
+// The value is converted to the type of the original value of the cell,
+// or written as text if it can't be.
+func (w *Writer) setTransformed(cell string, value string, original any) error {
+	var cellValue any = value
+	if t := reflect.TypeOf(original); t != nil && t.Kind() != reflect.String && len(value) > 0 {
+		if v, err := convert.ToValueE(value, t); err == nil && v.IsValid() {
+			cellValue = v.Interface()
+		}
+	}
+	if err := w.file.SetCellValue(w.Sheet.Name, cell, cellValue); err != nil {
+		return fmt.Errorf("excel: failed to set cell value at %s: %w", cell, err)
+	}
+	w.trackCell(cell, value)
+	return nil
+}
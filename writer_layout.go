@@ -0,0 +1,168 @@
+package excel
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// region is the part of the sheet written by a writer.
+// It is used to apply the layout options once the data has been written.
+type region struct {
+	Range
+
+	// widths are the estimated widths of the columns
+	widths map[int]float64
+	// fixed are the widths of the columns set with the width tag
+	fixed map[int]float64
+	// merged are the values of the columns given to WithMergeColumns, by column then by row
+	merged map[int]map[int]string
+}
+
+// WithFreezeHeader freezes the rows up to the title row written by Marshal
+// so that the titles stay visible while scrolling.
+func WithFreezeHeader() Option {
+	return func(o *options) {
+		o.freezeHeader = true
+	}
+}
+
+// WithAutoFilter adds an autofilter over the title row and the data rows written by Marshal.
+func WithAutoFilter() Option {
+	return func(o *options) {
+		o.autoFilter = true
+	}
+}
+
+// WithAutoFitColumns sets the width of the columns written by Marshal
+// from the length of their values. A maxWidth of 0 means no maximum width.
+// The width tag of a field has precedence on the estimated width.
+func WithAutoFitColumns(maxWidth float64) Option {
+	return func(o *options) {
+		o.autoFit = true
+		o.maxWidth = maxWidth
+	}
+}
+
+// track extends the written region to a cell and records the length of its text
+func (w *Writer) track(col, row int, text string) {
+	r := &w.region
+	if r.StartColumn == 0 || col < r.StartColumn {
+		r.StartColumn = col
+	}
+	if r.StartRow == 0 || row < r.StartRow {
+		r.StartRow = row
+	}
+	if col > r.EndColumn {
+		r.EndColumn = col
+	}
+	if row > r.EndRow {
+		r.EndRow = row
+	}
+	if !w.opts.autoFit {
+		return
+	}
+	if r.widths == nil {
+		r.widths = make(map[int]float64)
+	}
+	if width := textWidth(text); width > r.widths[col] {
+		r.widths[col] = width
+	}
+}
+
+// trackCell works like track for a cell name
+func (w *Writer) trackCell(cell string, text string) {
+	col, row, err := excelize.CellNameToCoordinates(cell)
+	if err == nil {
+		w.track(col, row, text)
+	}
+}
+
+// setWidth sets the width of a column whatever its content
+func (w *Writer) setWidth(col int, width float64) {
+	if w.region.fixed == nil {
+		w.region.fixed = make(map[int]float64)
+	}
+	w.region.fixed[col] = width
+}
+
+// applyLayout applies the layout options to the written region
+func (w *Writer) applyLayout() error {
+	r, sheet := &w.region, w.Sheet.Name
+
+	// Column widths
+	for col, width := range r.fixed {
+		name, err := excelize.ColumnNumberToName(col)
+		if err != nil {
+			return err
+		}
+		if err := w.file.SetColWidth(sheet, name, name, width); err != nil {
+			return fmt.Errorf("excel: failed to set width of column %s: %w", name, err)
+		}
+	}
+	if w.opts.autoFit {
+		for col, width := range r.widths {
+			if _, ok := r.fixed[col]; ok {
+				continue
+			}
+			if w.opts.maxWidth > 0 && width > w.opts.maxWidth {
+				width = w.opts.maxWidth
+			}
+			name, err := excelize.ColumnNumberToName(col)
+			if err != nil {
+				return err
+			}
+			if err := w.file.SetColWidth(sheet, name, name, width); err != nil {
+				return fmt.Errorf("excel: failed to set width of column %s: %w", name, err)
+			}
+		}
+	}
+
+	// Nothing has been written
+	if r.StartRow == 0 {
+		return nil
+	}
+
+	// Merge the identical values
+	if err := w.mergeColumns(); err != nil {
+		return err
+	}
+
+	// Freeze the rows up to the title row
+	if w.opts.freezeHeader {
+		topLeft, err := excelize.CoordinatesToCellName(1, r.StartRow+1)
+		if err != nil {
+			return err
+		}
+		err = w.file.SetPanes(sheet, &excelize.Panes{
+			Freeze:      true,
+			YSplit:      r.StartRow,
+			TopLeftCell: topLeft,
+			ActivePane:  "bottomLeft",
+		})
+		if err != nil {
+			return fmt.Errorf("excel: failed to freeze the title row: %w", err)
+		}
+	}
+
+	// Filter over the titles and the data
+	if w.opts.autoFilter {
+		if err := r.UpdateNames(); err != nil {
+			return err
+		}
+		if err := w.file.AutoFilter(sheet, r.ToRef(), nil); err != nil {
+			return fmt.Errorf("excel: failed to add autofilter on %s: %w", r.ToRef(), err)
+		}
+	}
+
+	return nil
+}
+
+// textWidth estimates the width of a column holding a text
+func textWidth(text string) float64 {
+	if len(text) == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(text)) + 2
+}
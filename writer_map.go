@@ -3,8 +3,10 @@ package excel
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 
+	"github.com/go-mods/convert"
 	"github.com/xuri/excelize/v2"
 )
 
@@ -89,8 +91,16 @@ func (w *MapWriter) writeRows(slice any) (*WriterResult, error) {
 	var keys []reflect.Value
 	var sortedKeys []string
 
+	// Number of rows written
+	written := 0
+
 	// Loop over slice rows
 	for i := 0; i < s.Len(); i++ {
+		// Stop if the operation has been cancelled
+		if err := w.Writer.opts.done(); err != nil {
+			return nil, err
+		}
+
 		// get values from map
 		values := s.Index(i)
 		if !values.IsValid() {
@@ -139,10 +149,12 @@ func (w *MapWriter) writeRows(slice any) (*WriterResult, error) {
 				if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, keyStr); err != nil {
 					return nil, fmt.Errorf("excel: failed to set cell value for header at %s: %w", cell, err)
 				}
+				w.Writer.track(col+j, row, keyStr)
 			}
 		}
 
-		// loop over columns
+		// Values of the row, by column
+		cells := make([]reflect.Value, len(sortedKeys))
 		for j, keyStr := range sortedKeys {
 			// Convert the string key back to a reflect.Value
 			var keyValue reflect.Value
@@ -161,13 +173,35 @@ func (w *MapWriter) writeRows(slice any) (*WriterResult, error) {
 			}
 
 			// get value from key
-			value := values.MapIndex(keyValue)
+			cells[j] = values.MapIndex(keyValue)
+		}
+
+		// Row filter
+		var encoded []string
+		var originals []any
+		if opts := &w.Writer.opts; opts.rowFilter != nil || opts.rowTransform != nil {
+			encoded = make([]string, len(cells))
+			originals = make([]any, len(cells))
+			for j, value := range cells {
+				if value.IsValid() {
+					originals[j] = value.Interface()
+					encoded[j] = convert.ToString(originals[j])
+				}
+			}
+			if opts.rowFilter != nil && !opts.rowFilter(encoded) {
+				continue
+			}
+		}
+		written++
+
+		// loop over columns
+		for j, value := range cells {
 			if !value.IsValid() {
 				continue
 			}
 
 			// cell
-			cell, err := excelize.CoordinatesToCellName(col+j, row+i+1)
+			cell, err := excelize.CoordinatesToCellName(col+j, row+written)
 			if err != nil {
 				return nil, fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
 			}
@@ -175,12 +209,28 @@ func (w *MapWriter) writeRows(slice any) (*WriterResult, error) {
 			if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, value.Interface()); err != nil {
 				return nil, fmt.Errorf("excel: failed to set cell value at %s: %w", cell, err)
 			}
+			w.Writer.track(col+j, row+written, fmt.Sprint(value.Interface()))
+
+			// Value of a merged column
+			if slices.Contains(w.Writer.opts.mergeColumns, sortedKeys[j]) {
+				w.Writer.trackMerge(col+j, row+written, fmt.Sprint(value.Interface()))
+			}
 		}
+
+		// Row transformation
+		if w.Writer.opts.rowTransform != nil {
+			if err := w.Writer.transformRow(encoded, originals, col, row+written); err != nil {
+				return nil, &RowError{Sheet: w.Writer.Sheet.Name, Row: row + written, Err: err}
+			}
+		}
+
+		// Report the progress
+		w.Writer.opts.notify(written)
 	}
 
 	// prepare the result
 	result := &WriterResult{}
-	result.Rows = s.Len()
+	result.Rows = written
 	result.Columns = len(sortedKeys)
 
 	return result, nil
@@ -1,8 +1,10 @@
 package excel
 
 import (
+	"fmt"
 	"reflect"
 
+	"github.com/go-mods/convert"
 	"github.com/xuri/excelize/v2"
 )
 
@@ -49,15 +51,23 @@ func (w *SliceWriter) writeRows(data any) (*WriterResult, error) {
 	}
 	s = s.Elem()
 
+	// The merged columns are given by their titles, which slices of slices don't have
+	if len(w.Writer.opts.mergeColumns) > 0 {
+		return nil, fmt.Errorf("%w: WithMergeColumns with slices of slices, which have no column titles", ErrNotImplemented)
+	}
+
 	// Get default coordinates
 	col, row, _ := excelize.CellNameToCoordinates(w.Writer.Axis.Axis)
 
 	// prepare the result
 	result := &WriterResult{}
-	result.Rows = s.Len()
 
 	// Write rows
 	for i := 0; i < s.Len(); i++ {
+		// Stop if the operation has been cancelled
+		if err := w.Writer.opts.done(); err != nil {
+			return nil, err
+		}
 
 		// data row
 		values := s.Index(i)
@@ -65,22 +75,47 @@ func (w *SliceWriter) writeRows(data any) (*WriterResult, error) {
 			values = values.Elem()
 		}
 
+		// Row filter
+		var encoded []string
+		var originals []any
+		if opts := &w.Writer.opts; opts.rowFilter != nil || opts.rowTransform != nil {
+			for j := 0; j < values.Len(); j++ {
+				originals = append(originals, values.Index(j).Interface())
+				encoded = append(encoded, convert.ToString(originals[j]))
+			}
+			if opts.rowFilter != nil && !opts.rowFilter(encoded) {
+				continue
+			}
+		}
+
 		// loop over columns
 		for j := 0; j < values.Len(); j++ {
 			// value
 			value := values.Index(j)
 
 			// cell
-			cell, _ := excelize.CoordinatesToCellName(col+j, row+i)
+			cell, _ := excelize.CoordinatesToCellName(col+j, row+result.Rows)
 			if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, value); err != nil {
 				return nil, err
 			}
+			w.Writer.track(col+j, row+result.Rows, fmt.Sprint(value.Interface()))
+		}
+
+		// Row transformation
+		if w.Writer.opts.rowTransform != nil {
+			if err := w.Writer.transformRow(encoded, originals, col, row+result.Rows); err != nil {
+				return nil, &RowError{Sheet: w.Writer.Sheet.Name, Row: row + result.Rows, Err: err}
+			}
 		}
 
 		// update the result
 		if result.Columns == 0 {
 			result.Columns = values.Len()
 		}
+		result.Rows++
+
+		// Report the progress
+		w.Writer.opts.notify(result.Rows)
 	}
 
 	return result, nil
@@ -3,7 +3,9 @@ package excel
 import (
 	"fmt"
 	"reflect"
+	"slices"
 
+	"github.com/go-mods/convert"
 	"github.com/xuri/excelize/v2"
 )
 
@@ -13,6 +15,18 @@ type StructWriter struct {
 	container *Container
 	Writer    *Writer
 	Struct    *Struct
+
+	// children writes the detail rows of a master-detail struct
+	children      *StructWriter
+	childrenField *Field
+
+	// extraField holds the columns which are not mapped to a field
+	extraField   *Field
+	extraColumns map[string]int
+
+	// repeatFields are the slice fields spread across repeated columns
+	repeatFields  []*Field
+	repeatColumns map[*Field][]int
 }
 
 // newStructWriter create the appropriate writer
@@ -46,6 +60,16 @@ func newStructWriter(writer *Writer, value reflect.Value) (*StructWriter, error)
 		Writer:    writer,
 		Struct:    structInfo,
 	}
+	if err := w.newChildrenWriter(); err != nil {
+		return nil, err
+	}
+	var err error
+	if w.extraField, err = structInfo.extraField(false); err != nil {
+		return nil, err
+	}
+	if w.repeatFields, err = structInfo.repeatFields(false); err != nil {
+		return nil, err
+	}
 	return w, nil
 }
 
@@ -67,6 +91,12 @@ func (w *StructWriter) Marshall(data any) (*WriterResult, error) {
 		return nil, fmt.Errorf("excel: data is nil")
 	}
 
+	// Locale of the numbers written as text
+	w.Struct.applyLocale(w.Writer.opts.locale, false)
+	if w.children != nil {
+		w.children.Struct.applyLocale(w.Writer.opts.locale, false)
+	}
+
 	// get excel rows to find titles if exists
 	rows, err := w.Writer.file.Rows(w.Writer.Sheet.Name)
 	if err != nil {
@@ -93,6 +123,11 @@ func (w *StructWriter) Marshall(data any) (*WriterResult, error) {
 
 	//
 	w.updateColumnIndex(titleRow)
+	if w.children != nil {
+		w.updateChildrenColumnIndex(titleRow)
+	}
+	w.updateRepeatColumns(titleRow, data)
+	w.updateExtraColumns(titleRow, data)
 
 	// Write
 	count, err := w.writeRows(data)
@@ -100,10 +135,32 @@ func (w *StructWriter) Marshall(data any) (*WriterResult, error) {
 		return nil, fmt.Errorf("excel: failed to write rows: %w", err)
 	}
 
+	// Conditional formats of the data rows
+	if err := w.writeConditionalFormats(w.Writer.Axis.Row+1, count); err != nil {
+		return nil, err
+	}
+	if w.children != nil {
+		if err := w.children.writeConditionalFormats(w.Writer.Axis.Row+1, count); err != nil {
+			return nil, err
+		}
+	}
+
+	// Totals row
+	if err := w.writeTotals(w.Writer.Axis.Row+1, count); err != nil {
+		return nil, fmt.Errorf("excel: failed to write totals: %w", err)
+	}
+
 	// prepare the result
 	result := &WriterResult{}
 	result.Rows = count
 	result.Columns = w.Struct.Fields.Count() - w.Struct.Fields.CountWriteIgnored()
+	if w.children != nil {
+		result.Columns += w.children.Struct.Fields.Count() - w.children.Struct.Fields.CountWriteIgnored()
+	}
+	for _, columns := range w.repeatColumns {
+		result.Columns += len(columns)
+	}
+	result.Columns += len(w.extraColumns)
 
 	return result, nil
 }
@@ -195,25 +252,43 @@ func (w *StructWriter) writeRows(slice any) (row int, err error) {
 
 	// Write title
 	// -----------
-	for _, f := range w.Struct.Fields {
-		if f == nil || f.GetWriteIgnore() {
-			continue
-		}
-
-		cell, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, row)
-		if err != nil {
-			return 0, fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+	if err := w.writeTitles(col, row); err != nil {
+		return 0, err
+	}
+	if w.children != nil {
+		if err := w.children.writeTitles(col, row); err != nil {
+			return 0, err
 		}
+	}
+	if err := w.writeRepeatTitles(col, row); err != nil {
+		return 0, err
+	}
+	if err := w.writeExtraTitles(col, row); err != nil {
+		return 0, err
+	}
+	row++
 
-		if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, f.GetWriteColumnName()); err != nil {
-			return 0, fmt.Errorf("excel: failed to set cell value for title at %s: %w", cell, err)
+	// Key of the subtotal rows
+	var key *Field
+	if name := w.Writer.opts.subtotalKey; len(name) > 0 {
+		if key = w.Struct.GetFieldByName(name); key == nil || key.GetWriteIgnore() {
+			return 0, fmt.Errorf("excel: subtotal key '%s' is not a written field", name)
 		}
 	}
-	row++
+	var group string
+	groupRow := row
+
+	// Number of elements written
+	written := 0
 
 	// Write rows
 	// ----------
 	for i := 0; i < s.Len(); i++ {
+		// Stop if the operation has been cancelled
+		if err := w.Writer.opts.done(); err != nil {
+			return row - 1, err
+		}
+
 		col, _, err = excelize.CellNameToCoordinates(w.Writer.Axis.Axis)
 		if err != nil {
 			return 0, fmt.Errorf("excel: invalid axis '%s': %w", w.Writer.Axis.Axis, err)
@@ -236,39 +311,265 @@ func (w *StructWriter) writeRows(slice any) (row int, err error) {
 			return 0, fmt.Errorf("excel: expected struct, got %v at index %d", values.Kind(), i)
 		}
 
-		// write
-		for _, f := range w.Struct.Fields {
-			if f == nil || f.GetWriteIgnore() {
-				continue
-			}
-
-			// Get the field value using the container's findFieldByIndex
-			fieldValue, err := w.container.findFieldByIndex(values, f.Index)
-			if err != nil {
-				return 0, fmt.Errorf("excel: failed to find field at index %d: %w", f.Index, err)
+		// Call the before hook
+		if hook, ok := hookTarget(values).(BeforeMarshalRowHook); ok {
+			if err := hook.BeforeMarshalRow(); err != nil {
+				return 0, &RowError{Sheet: w.Writer.Sheet.Name, Row: row, Err: err}
 			}
+		}
 
-			if fieldValue.Kind() == reflect.Pointer && fieldValue.IsNil() {
+		// Row filter
+		var cells map[int]rowCell
+		var encoded []string
+		if opts := &w.Writer.opts; opts.rowFilter != nil || opts.rowTransform != nil {
+			cells = w.rowCells(values)
+			encoded = encodeRow(cells)
+			if opts.rowFilter != nil && !opts.rowFilter(encoded) {
 				continue
 			}
+		}
 
-			cell, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, row)
+		// Subtotal of the previous group
+		if key != nil {
+			keyValue, err := w.container.findFieldByIndex(values, key.Index)
 			if err != nil {
-				return 0, fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+				return 0, fmt.Errorf("excel: failed to find field at index %d: %w", key.Index, err)
 			}
+			current := groupKey(keyValue)
+			if row > groupRow && current != group {
+				if err := w.writeSubtotal(row, groupRow, row-1, key, group); err != nil {
+					return 0, fmt.Errorf("excel: failed to write subtotal of '%s': %w", group, err)
+				}
+				row++
+				groupRow = row
+			}
+			group = current
+		}
 
-			cellValue, err := f.toCellValue(fieldValue.Interface())
-			if err != nil {
-				return 0, fmt.Errorf("excel: failed to convert value for field '%s': %w", f.Name, err)
+		// write
+		rows, err := w.writeElement(values, col, row)
+		if err != nil {
+			return 0, &RowError{Sheet: w.Writer.Sheet.Name, Row: row, Err: err}
+		}
+
+		// Row transformation
+		if w.Writer.opts.rowTransform != nil {
+			if err := w.transformRow(cells, encoded, col, row); err != nil {
+				return 0, &RowError{Sheet: w.Writer.Sheet.Name, Row: row, Err: err}
 			}
+		}
 
-			if err = w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, cellValue); err != nil {
-				return 0, fmt.Errorf("excel: failed to set cell value at %s: %w", cell, err)
+		// Outline level of the rows
+		for r := row; r < row+rows; r++ {
+			if err := w.Writer.writeOutlineLevel(values, r); err != nil {
+				return 0, fmt.Errorf("excel: failed to set outline level of row %d: %w", r, err)
 			}
 		}
 
+		row += rows
+		written++
+
+		// Report the progress
+		w.Writer.opts.notify(written)
+	}
+
+	// Subtotal of the last group
+	if key != nil && row > groupRow {
+		if err := w.writeSubtotal(row, groupRow, row-1, key, group); err != nil {
+			return 0, fmt.Errorf("excel: failed to write subtotal of '%s': %w", group, err)
+		}
 		row++
 	}
 
 	return row - 1, nil
 }
+
+// writeTitles writes the titles of the fields in the title row
+func (w *StructWriter) writeTitles(col, row int) error {
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+
+		cell, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, row)
+		if err != nil {
+			return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+		}
+
+		if err := w.Writer.file.SetCellValue(w.Writer.Sheet.Name, cell, f.GetWriteColumnName()); err != nil {
+			return fmt.Errorf("excel: failed to set cell value for title at %s: %w", cell, err)
+		}
+		w.Writer.track(col+f.WriteTags.index, row, f.GetWriteColumnName())
+
+		// Width of the column
+		if width := f.GetWriteWidth(); width > 0 {
+			w.Writer.setWidth(col+f.WriteTags.index, width)
+		}
+
+		// Hidden column
+		if err := w.Writer.writeHiddenColumn(f, col+f.WriteTags.index); err != nil {
+			return fmt.Errorf("excel: failed to hide column of field '%s': %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// rowCell is a value written in a row with the field which encodes it.
+// The field is nil for the values of the extra field.
+type rowCell struct {
+	field *Field
+	value reflect.Value
+}
+
+// rowCells returns the values written for an element, by column:
+// the fields, the elements of the repeated fields and the extra values.
+func (w *StructWriter) rowCells(values reflect.Value) map[int]rowCell {
+	cells := make(map[int]rowCell)
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+		if fieldValue, err := w.container.findFieldByIndex(values, f.Index); err == nil {
+			cells[f.WriteTags.index] = rowCell{field: f, value: fieldValue}
+		}
+	}
+	for f, columns := range w.repeatColumns {
+		slice, err := w.container.findFieldByIndex(values, f.Index)
+		if err != nil {
+			continue
+		}
+		elem := f.withType(f.Type.Elem())
+		for i := 0; i < slice.Len() && i < len(columns); i++ {
+			cells[columns[i]] = rowCell{field: elem, value: slice.Index(i)}
+		}
+	}
+	if w.extraField != nil {
+		if extra, err := w.container.findFieldByIndex(values, w.extraField.Index); err == nil {
+			iter := extra.MapRange()
+			for iter.Next() {
+				if index, ok := w.extraColumns[iter.Key().String()]; ok {
+					cells[index] = rowCell{value: iter.Value()}
+				}
+			}
+		}
+	}
+	return cells
+}
+
+// encodeRow returns the encoded values of the cells of an element, by column.
+// The values which can't be encoded are empty.
+func encodeRow(cells map[int]rowCell) []string {
+	var row []string
+	for index, c := range cells {
+		for len(row) <= index {
+			row = append(row, "")
+		}
+		if c.field == nil {
+			row[index] = convert.ToString(c.value.Interface())
+		} else {
+			row[index] = encodeValue(c.field, c.value)
+		}
+	}
+	return row
+}
+
+// encodeValue returns the encoded value of a field.
+// The value is empty if it can't be encoded.
+func encodeValue(f *Field, fieldValue reflect.Value) string {
+	if fieldValue.Kind() == reflect.Pointer && fieldValue.IsNil() {
+		return ""
+	}
+	cellValue, err := f.toCellValue(fieldValue.Interface())
+	if err != nil {
+		return ""
+	}
+	return convert.ToString(cellValue)
+}
+
+// transformRow writes the values changed by the row transformation.
+// A changed value is decoded by the field of its cell and written with its type;
+// otherwise it is converted to the type of the original value.
+func (w *StructWriter) transformRow(cells map[int]rowCell, encoded []string, col, row int) error {
+	transformed := w.Writer.opts.rowTransform(slices.Clone(encoded))
+	for index, value := range transformed {
+		if index < len(encoded) && encoded[index] == value {
+			continue
+		}
+		cell, err := excelize.CoordinatesToCellName(col+index, row)
+		if err != nil {
+			return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+		}
+		c, ok := cells[index]
+		if ok && c.field != nil && len(value) > 0 {
+			if decoded, err := c.field.decodeWritten(value); err == nil && decoded.IsValid() {
+				if err := w.writeCell(c.field, cell, row, decoded); err != nil {
+					return fmt.Errorf("excel: failed to set cell value at %s: %w", cell, err)
+				}
+				continue
+			}
+		}
+		var original any
+		if ok {
+			original = c.value.Interface()
+		}
+		if err := w.Writer.setTransformed(cell, value, original); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFields writes the fields of an element in a row
+func (w *StructWriter) writeFields(values reflect.Value, col, row int) error {
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+
+		// Get the field value using the container's findFieldByIndex
+		fieldValue, err := w.container.findFieldByIndex(values, f.Index)
+		if err != nil {
+			return fmt.Errorf("excel: failed to find field at index %d: %w", f.Index, err)
+		}
+
+		cell, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, row)
+		if err != nil {
+			return fmt.Errorf("excel: failed to convert coordinates to cell name: %w", err)
+		}
+
+		if err = w.writeCell(f, cell, row, fieldValue); err != nil {
+			return &CellError{
+				Sheet: w.Writer.Sheet.Name,
+				Cell:  cell,
+				Row:   row,
+				Col:   col + f.WriteTags.index,
+				Value: fmt.Sprint(fieldValue.Interface()),
+				Type:  f.Type.String(),
+				Err:   fmt.Errorf("%w: %w", ErrCellWrite, err),
+			}
+		}
+
+		// Number format
+		if err = w.writeNumFmt(f, cell); err != nil {
+			return fmt.Errorf("excel: failed to set number format at %s: %w", cell, err)
+		}
+
+		// Value of a merged column
+		if slices.Contains(w.Writer.opts.mergeColumns, f.GetWriteColumnName()) {
+			w.Writer.trackMerge(col+f.WriteTags.index, row, encodeValue(f, fieldValue))
+		}
+
+		// Comment taken from another field
+		if comment, ok, err := w.commentFrom(f, values); err != nil {
+			return fmt.Errorf("excel: failed to get comment of field '%s': %w", f.Name, err)
+		} else if ok {
+			if err = w.writeComment(cell, comment); err != nil {
+				return fmt.Errorf("excel: failed to set comment at %s: %w", cell, err)
+			}
+		}
+	}
+	if err := w.writeRepeat(values, col, row); err != nil {
+		return err
+	}
+	return w.writeExtra(values, col, row)
+}
@@ -1,8 +1,14 @@
 package excel
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -528,3 +534,1030 @@ func TestStructNamedUserReadWrite(t *testing.T) {
 		assert.Equal(t, originalUsers[0].AnArray[i], readUsers[0].AnArray[i], "Array elements should match")
 	}
 }
+
+// TestMarshalContext verifies that writing can be cancelled and reports its progress.
+// It tests:
+// - Progress callback calls
+// - Cancellation between rows
+func TestMarshalContext(t *testing.T) {
+	named := []Named{{ID: 1, Name: "One"}, {ID: 2, Name: "Two"}, {ID: 3, Name: "Three"}}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	var progress []int
+	xl, _ := NewWriter(file, WithProgress(func(rowsDone int) {
+		progress = append(progress, rowsDone)
+	}))
+
+	err := xl.MarshalContext(context.Background(), &named)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, progress)
+
+	// A cancelled context stops the writer before the first row
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = xl.MarshalContext(ctx, &[]Named{{ID: 4, Name: "Four"}})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	value, _ := file.GetCellValue(xl.Sheet().Name, "A2")
+	assert.Equal(t, "1", value)
+}
+
+// TestRowFilterAndTransformWrite verifies the row filter and the row transformation when writing.
+// It tests:
+// - Progress of the written rows only
+// - Repeated and extra columns given to the filter
+// - Types of the transformed values
+// - Slice and map writers
+func TestRowFilterAndTransformWrite(t *testing.T) {
+	type Item struct {
+		ID     int               `excel:"Id"`
+		Name   string            `excel:"Name"`
+		Tags   []string          `excel:"repeat:Tag {n}"`
+		Extra  map[string]string `excel:",extra"`
+		Amount float64           `excel:"Amount"`
+	}
+	items := []Item{
+		{ID: 1, Name: "Pen", Tags: []string{"blue"}, Extra: map[string]string{"Origin": "FR"}, Amount: 2.5},
+		{ID: 2, Name: "Ink", Tags: []string{"skip"}, Extra: map[string]string{"Origin": "DE"}, Amount: 4},
+		{ID: 3, Name: "Pad", Tags: []string{"red"}, Extra: map[string]string{"Origin": "IT"}, Amount: 1},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	var progress []int
+	xl, _ := NewWriter(file,
+		WithProgress(func(rowsDone int) { progress = append(progress, rowsDone) }),
+		WithRowFilter(func(encoded []string) bool { return encoded[3] != "skip" }),
+		WithRowTransform(func(encoded []string) []string {
+			encoded[0] = encoded[0] + "0"
+			encoded[2] = encoded[2] + "0"
+			encoded[4] = strings.ToLower(encoded[4])
+			return encoded
+		}),
+	)
+	assert.NoError(t, xl.Marshal(&items))
+	assert.Equal(t, []int{1, 2}, progress)
+
+	rows, _ := file.GetRows(xl.Sheet().Name)
+	assert.Equal(t, [][]string{
+		{"Id", "Name", "Amount", "Tag 1", "Origin"},
+		{"10", "Pen", "2.5", "blue", "fr"},
+		{"30", "Pad", "10", "red", "it"},
+	}, rows)
+
+	// The transformed numbers are kept as numbers
+	for _, cell := range []string{"A2", "C2", "A3"} {
+		cellType, _ := file.GetCellType(xl.Sheet().Name, cell)
+		assert.NotEqual(t, excelize.CellTypeSharedString, cellType, cell)
+		assert.NotEqual(t, excelize.CellTypeInlineString, cellType, cell)
+	}
+
+	// Slices of slices
+	matrix := excelize.NewFile()
+	defer func() { _ = matrix.Close() }()
+
+	progress = nil
+	xl, _ = NewWriter(matrix,
+		WithProgress(func(rowsDone int) { progress = append(progress, rowsDone) }),
+		WithRowFilter(func(encoded []string) bool { return encoded[0] != "2" }),
+		WithRowTransform(func(encoded []string) []string {
+			encoded[1] = encoded[1] + "0"
+			return encoded
+		}),
+	)
+	assert.NoError(t, xl.Marshal(&[][]int{{1, 10}, {2, 20}, {3, 30}}))
+	assert.Equal(t, []int{1, 2}, progress)
+	assert.Equal(t, 2, xl.Writer.Result.Rows)
+
+	rows, _ = matrix.GetRows(xl.Sheet().Name)
+	assert.Equal(t, [][]string{{"1", "100"}, {"3", "300"}}, rows)
+	cellType, _ := matrix.GetCellType(xl.Sheet().Name, "B2")
+	assert.NotEqual(t, excelize.CellTypeSharedString, cellType)
+
+	// Slices of maps
+	maps := excelize.NewFile()
+	defer func() { _ = maps.Close() }()
+
+	progress = nil
+	xl, _ = NewWriter(maps,
+		WithProgress(func(rowsDone int) { progress = append(progress, rowsDone) }),
+		WithRowFilter(func(encoded []string) bool { return encoded[0] != "2" }),
+		WithRowTransform(func(encoded []string) []string {
+			encoded[1] = strings.ToUpper(encoded[1])
+			return encoded
+		}),
+	)
+	assert.NoError(t, xl.Marshal(&[]map[string]any{
+		{"Id": 1, "Name": "Pen"},
+		{"Id": 2, "Name": "Ink"},
+		{"Id": 3, "Name": "Pad"},
+	}))
+	assert.Equal(t, []int{1, 2}, progress)
+	assert.Equal(t, 2, xl.Writer.Result.Rows)
+
+	rows, _ = maps.GetRows(xl.Sheet().Name)
+	assert.Equal(t, [][]string{{"Id", "Name"}, {"1", "PEN"}, {"3", "PAD"}}, rows)
+}
+
+// TestFormulaAndHyperlinkWrite verifies writing formulas and hyperlinks.
+// It tests:
+// - Formula templates
+// - Formula type and formula tag
+// - Hyperlink type
+// - Reading them back
+func TestFormulaAndHyperlinkWrite(t *testing.T) {
+
+	type Line struct {
+		Product  Hyperlink `excel:"Product"`
+		Quantity int       `excel:"Quantity"`
+		Price    float64   `excel:"Price"`
+		Total    float64   `excel:"Total,formula:B{row}*C{row}" excel-in:"-"`
+		Check    string    `excel:"Check,formula"`
+		Rounded  Formula   `excel:"Rounded"`
+	}
+
+	lines := []Line{
+		{Product: Hyperlink{URL: "https://go.dev", Text: "Go", Tooltip: "Go website"}, Quantity: 2, Price: 1.5, Check: "=D2>0", Rounded: "ROUND(D2,0)"},
+		{Product: Hyperlink{URL: "#Sheet1!A1"}, Quantity: 3, Price: 2, Rounded: "=ROUND(D3,0)"},
+		{Product: Hyperlink{URL: "report.xlsx", Text: "Report"}, Quantity: 1, Price: 1, Rounded: "=ROUND(D4,0)"},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	err := xl.Marshal(&lines)
+	assert.NoError(t, err)
+
+	sheet := xl.Sheet().Name
+	formula, _ := file.GetCellFormula(sheet, "D2")
+	assert.Equal(t, "B2*C2", formula)
+	formula, _ = file.GetCellFormula(sheet, "D3")
+	assert.Equal(t, "B3*C3", formula)
+	formula, _ = file.GetCellFormula(sheet, "E2")
+	assert.Equal(t, "D2>0", formula)
+	value, _ := file.GetCellValue(sheet, "E3")
+	assert.Equal(t, "", value)
+	formula, _ = file.GetCellFormula(sheet, "F3")
+	assert.Equal(t, "ROUND(D3,0)", formula)
+
+	value, _ = file.GetCellValue(sheet, "A2")
+	assert.Equal(t, "Go", value)
+	ok, link, _ := file.GetCellHyperLink(sheet, "A2")
+	assert.True(t, ok)
+	assert.Equal(t, "https://go.dev", link)
+	value, _ = file.GetCellValue(sheet, "A3")
+	assert.Equal(t, "#Sheet1!A1", value)
+	_, link, _ = file.GetCellHyperLink(sheet, "A3")
+	assert.Equal(t, "Sheet1!A1", link)
+
+	// Read back
+	in, _ := NewReader(file)
+	var read []Line
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Len(t, read, 3)
+	assert.Equal(t, Hyperlink{URL: "https://go.dev", Text: "Go"}, read[0].Product)
+	assert.Equal(t, Hyperlink{URL: "#Sheet1!A1", Text: "#Sheet1!A1"}, read[1].Product)
+	assert.Equal(t, Hyperlink{URL: "report.xlsx", Text: "Report"}, read[2].Product)
+	assert.Equal(t, Formula("ROUND(D2,0)"), read[0].Rounded)
+}
+
+// TestCommentWrite verifies writing and reading cell comments.
+// It tests:
+// - Annotated values, including nil values
+// - commentFrom tag
+// - Reading them back
+func TestCommentWrite(t *testing.T) {
+
+	type Product struct {
+		Name      Annotated[string] `excel:"Name"`
+		Price     float64           `excel:"Price,commentFrom:PriceNote"`
+		PriceNote string            `excel:"-"`
+	}
+
+	products := []Product{
+		{Name: Annotated[string]{Value: "Apple", Comment: "Fresh"}, Price: 1.5, PriceNote: "Per kilo"},
+		{Name: Annotated[string]{Value: "Pear"}, Price: 2},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	err := xl.Marshal(&products)
+	assert.NoError(t, err)
+
+	sheet := xl.Sheet().Name
+	value, _ := file.GetCellValue(sheet, "A2")
+	assert.Equal(t, "Apple", value)
+	comments, err := file.GetComments(sheet)
+	assert.NoError(t, err)
+	assert.Len(t, comments, 2)
+
+	// Read back
+	in, _ := NewReader(file)
+	var read []Product
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, products, read)
+
+	// Nil values are written as an empty cell with their comment
+	type Note struct {
+		Note Annotated[any] `excel:"Note"`
+	}
+	notes := excelize.NewFile()
+	defer func() { _ = notes.Close() }()
+
+	xl, _ = NewWriter(notes)
+	assert.NoError(t, xl.Marshal(&[]Note{{}, {Note: Annotated[any]{Comment: "Missing"}}}))
+	value, _ = notes.GetCellValue(xl.Sheet().Name, "A3")
+	assert.Equal(t, "", value)
+	comments, _ = notes.GetComments(xl.Sheet().Name)
+	assert.Len(t, comments, 1)
+}
+
+// TestLayoutOptions verifies the layout options applied after writing.
+// It tests:
+// - WithFreezeHeader
+// - WithAutoFilter
+// - WithAutoFitColumns and the width tag
+func TestLayoutOptions(t *testing.T) {
+
+	type Product struct {
+		Name        string  `excel:"Name"`
+		Description string  `excel:"Description"`
+		Price       float64 `excel:"Price,width:20"`
+	}
+
+	products := []Product{
+		{Name: "Apple", Description: "A very long description of the apple", Price: 1.5},
+		{Name: "Pear", Description: "Pear", Price: 2},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file, WithFreezeHeader(), WithAutoFilter(), WithAutoFitColumns(30))
+	err := xl.Marshal(&products)
+	assert.NoError(t, err)
+
+	sheet := xl.Sheet().Name
+	panes, err := file.GetPanes(sheet)
+	assert.NoError(t, err)
+	assert.True(t, panes.Freeze)
+	assert.Equal(t, 1, panes.YSplit)
+	assert.Equal(t, "A2", panes.TopLeftCell)
+
+	width, _ := file.GetColWidth(sheet, "A")
+	assert.Equal(t, float64(len("Apple")+2), width)
+	width, _ = file.GetColWidth(sheet, "B")
+	assert.Equal(t, float64(30), width)
+	width, _ = file.GetColWidth(sheet, "C")
+	assert.Equal(t, float64(20), width)
+
+	names := file.GetDefinedName()
+	assert.Len(t, names, 1)
+	assert.Equal(t, "_xlnm._FilterDatabase", names[0].Name)
+	assert.Equal(t, "'Sheet1'!$A$1:$C$3", names[0].RefersTo)
+}
+
+type FormattedProduct struct {
+	Name   string  `excel:"Name"`
+	Margin float64 `excel:"Margin,cf:<0:red|>0.3:green"`
+	Stock  int     `excel:"Stock"`
+}
+
+func (p *FormattedProduct) ConditionalFormats() map[string][]ConditionalFormat {
+	return map[string][]ConditionalFormat{
+		"Stock": {{Type: ConditionalDataBar, Color: "blue"}},
+	}
+}
+
+// TestConditionalFormatWrite verifies writing conditional formats.
+// It tests:
+// - Parsing the cf tag
+// - cf tag and ConditionalFormatter interface
+// - Range of the formats
+func TestConditionalFormatWrite(t *testing.T) {
+
+	formats, err := ParseConditionalFormats("<0:red|>=0.3:#00FF00|colorscale:red:green")
+	assert.NoError(t, err)
+	assert.Equal(t, []ConditionalFormat{
+		{Type: ConditionalCell, Criteria: "<", Value: "0", Color: "red"},
+		{Type: ConditionalCell, Criteria: ">=", Value: "0.3", Color: "#00FF00"},
+		{Type: ConditionalColorScale, Color: "red", MaxColor: "green"},
+	}, formats)
+
+	_, err = ParseConditionalFormats("0:red")
+	assert.ErrorIs(t, err, ErrConditionalFormatNotValid)
+
+	products := []FormattedProduct{
+		{Name: "Apple", Margin: -0.1, Stock: 10},
+		{Name: "Pear", Margin: 0.5, Stock: 20},
+		{Name: "Plum", Margin: 0.2, Stock: 5},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	err = xl.Marshal(&products)
+	assert.NoError(t, err)
+
+	cf, err := file.GetConditionalFormats(xl.Sheet().Name)
+	assert.NoError(t, err)
+	assert.Len(t, cf, 2)
+	assert.Len(t, cf["B2:B4"], 2)
+	assert.Equal(t, "less than", cf["B2:B4"][0].Criteria)
+	assert.Len(t, cf["C2:C4"], 1)
+	assert.Equal(t, "data_bar", cf["C2:C4"][0].Type)
+}
+
+// TestTotalsWrite verifies writing totals and subtotals.
+// It tests:
+// - Totals row
+// - Subtotal rows
+// - Totals row of a table
+func TestTotalsWrite(t *testing.T) {
+
+	type Sale struct {
+		Region   string  `excel:"Region"`
+		Quantity int     `excel:"Quantity,total:sum"`
+		Price    float64 `excel:"Price,total:avg"`
+	}
+
+	sales := []Sale{
+		{Region: "East", Quantity: 2, Price: 1.5},
+		{Region: "East", Quantity: 3, Price: 2},
+		{Region: "West", Quantity: 4, Price: 3},
+	}
+
+	t.Run("Totals", func(t *testing.T) {
+		file := excelize.NewFile()
+		defer func() { _ = file.Close() }()
+
+		xl, _ := NewWriter(file)
+		assert.NoError(t, xl.Marshal(&sales))
+
+		sheet := xl.Sheet().Name
+		value, _ := file.GetCellValue(sheet, "A5")
+		assert.Equal(t, "Total", value)
+		formula, _ := file.GetCellFormula(sheet, "B5")
+		assert.Equal(t, "SUM(B2:B4)", formula)
+		formula, _ = file.GetCellFormula(sheet, "C5")
+		assert.Equal(t, "AVERAGE(C2:C4)", formula)
+	})
+
+	t.Run("Subtotals", func(t *testing.T) {
+		file := excelize.NewFile()
+		defer func() { _ = file.Close() }()
+
+		xl, _ := NewWriter(file, WithSubtotals("Region"))
+		assert.NoError(t, xl.Marshal(&sales))
+
+		sheet := xl.Sheet().Name
+		value, _ := file.GetCellValue(sheet, "A4")
+		assert.Equal(t, "East Total", value)
+		formula, _ := file.GetCellFormula(sheet, "B4")
+		assert.Equal(t, "SUBTOTAL(9,B2:B3)", formula)
+		value, _ = file.GetCellValue(sheet, "A5")
+		assert.Equal(t, "West", value)
+		value, _ = file.GetCellValue(sheet, "A6")
+		assert.Equal(t, "West Total", value)
+		formula, _ = file.GetCellFormula(sheet, "C6")
+		assert.Equal(t, "SUBTOTAL(1,C5:C5)", formula)
+		value, _ = file.GetCellValue(sheet, "A7")
+		assert.Equal(t, "Total", value)
+		formula, _ = file.GetCellFormula(sheet, "B7")
+		assert.Equal(t, "SUBTOTAL(9,B2:B6)", formula)
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		file := excelize.NewFile()
+		defer func() { _ = file.Close() }()
+
+		assert.NoError(t, file.SetSheetRow("Sheet1", "A1", &[]string{"Region", "Quantity", "Price"}))
+		assert.NoError(t, file.AddTable("Sheet1", &excelize.Table{Range: "A1:C2", Name: "Sales"}))
+
+		xl, _ := NewWriter(file)
+		assert.NoError(t, xl.Marshal(&sales))
+
+		sheet := xl.Sheet().Name
+		formula, _ := file.GetCellFormula(sheet, "B5")
+		assert.Equal(t, "SUBTOTAL(109,Sales[Quantity])", formula)
+
+		value, _ := file.GetCellValue(sheet, "A5")
+		assert.Equal(t, "Total", value)
+
+		// The totals row is the totals row of the table
+		buf, err := file.WriteToBuffer()
+		assert.NoError(t, err)
+		saved, err := excelize.OpenReader(buf)
+		assert.NoError(t, err)
+		defer func() { _ = saved.Close() }()
+		tables, err := saved.GetTables(sheet)
+		assert.NoError(t, err)
+		assert.Len(t, tables, 1)
+		assert.Equal(t, "$A$1:$C$5", tables[0].Range)
+
+		part, ok := saved.Pkg.Load("xl/tables/table1.xml")
+		assert.True(t, ok)
+		assert.Regexp(t, `<table [^>]*totalsRowCount="1"`, string(part.([]byte)))
+		assert.NotRegexp(t, `totalsRowShown="(0|false)"`, string(part.([]byte)))
+		assert.Regexp(t, `<autoFilter ref="A1:C4"`, string(part.([]byte)))
+		assert.Regexp(t, `<tableColumn [^>]*name="Region"[^>]*totalsRowLabel="Total"`, string(part.([]byte)))
+		assert.Regexp(t, `<tableColumn [^>]*name="Quantity"[^>]*totalsRowFunction="sum"`, string(part.([]byte)))
+		assert.Regexp(t, `<tableColumn [^>]*name="Price"[^>]*totalsRowFunction="average"`, string(part.([]byte)))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		type Invalid struct {
+			Quantity int `excel:"Quantity,total:median"`
+		}
+		file := excelize.NewFile()
+		defer func() { _ = file.Close() }()
+
+		xl, _ := NewWriter(file)
+		err := xl.Marshal(&[]Invalid{{Quantity: 1}})
+		assert.ErrorIs(t, err, ErrTotalNotValid)
+	})
+}
+
+type OutlinedItem struct {
+	Name  string `excel:"Name"`
+	Level int    `excel:"-"`
+}
+
+func (i *OutlinedItem) OutlineLevel() int {
+	return i.Level
+}
+
+func (i *OutlinedItem) SetOutlineLevel(level int) {
+	i.Level = level
+}
+
+// TestOutlineLevels verifies writing and reading outline levels.
+// It tests:
+// - OutlineLeveler interface
+// - WithCollapsedOutlines option, with the summary rows collapsed
+// - WithOutlineLevels option and OutlineLevelSetter interface
+func TestOutlineLevels(t *testing.T) {
+
+	items := []OutlinedItem{
+		{Name: "Order 1"},
+		{Name: "Line 1", Level: 1},
+		{Name: "Line 2", Level: 1},
+		{Name: "Order 2"},
+		{Name: "Line 3", Level: 1},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file, WithCollapsedOutlines())
+	assert.NoError(t, xl.Marshal(&items))
+
+	sheet := xl.Sheet().Name
+	level, _ := file.GetRowOutlineLevel(sheet, 3)
+	assert.Equal(t, uint8(1), level)
+	level, _ = file.GetRowOutlineLevel(sheet, 5)
+	assert.Equal(t, uint8(0), level)
+	visible, _ := file.GetRowVisible(sheet, 3)
+	assert.False(t, visible)
+	visible, _ = file.GetRowVisible(sheet, 5)
+	assert.True(t, visible)
+
+	// The summary rows of the groups are collapsed
+	buf, err := file.WriteToBuffer()
+	assert.NoError(t, err)
+	saved, err := excelize.OpenReader(buf)
+	assert.NoError(t, err)
+	part, ok := saved.Pkg.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.Regexp(t, `<row r="2"[^>]*collapsed="true"`, string(part.([]byte)))
+	assert.Regexp(t, `<row r="5"[^>]*collapsed="true"`, string(part.([]byte)))
+	assert.NotRegexp(t, `<row r="3"[^>]*collapsed`, string(part.([]byte)))
+
+	// Read back
+	in, _ := NewReader(file, WithOutlineLevels())
+	var read []OutlinedItem
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, items, read)
+	assert.Equal(t, []int{0, 1, 1, 0, 1}, in.Reader.Result.OutlineLevels)
+}
+
+type InvoiceLine struct {
+	Product  string  `excel:"Product"`
+	Quantity int     `excel:"Quantity"`
+	Price    float64 `excel:"Price"`
+}
+
+type Invoice struct {
+	Number   string        `excel:"Invoice,key"`
+	Customer string        `excel:"Customer"`
+	Lines    []InvoiceLine `excel:"children"`
+}
+
+// TestMasterDetail verifies writing and reading master-detail structs.
+// It tests:
+// - Flattening the children on write
+// - Merged parent cells
+// - Grouping the rows by parent key on read
+func TestMasterDetail(t *testing.T) {
+
+	invoices := []Invoice{
+		{Number: "F1", Customer: "Alice", Lines: []InvoiceLine{
+			{Product: "Apple", Quantity: 2, Price: 1.5},
+			{Product: "Pear", Quantity: 1, Price: 2},
+		}},
+		{Number: "F2", Customer: "Bob", Lines: []InvoiceLine{
+			{Product: "Plum", Quantity: 5, Price: 0.5},
+		}},
+		{Number: "F3", Customer: "Carol"},
+	}
+
+	for _, merge := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Merge=%v", merge), func(t *testing.T) {
+			file := excelize.NewFile()
+			defer func() { _ = file.Close() }()
+
+			var opts []Option
+			if merge {
+				opts = append(opts, WithMergeParentCells())
+			}
+			xl, _ := NewWriter(file, opts...)
+			assert.NoError(t, xl.Marshal(&invoices))
+			assert.Equal(t, 5, xl.Writer.Result.Columns)
+
+			sheet := xl.Sheet().Name
+			rows, _ := file.GetRows(sheet)
+			assert.Equal(t, []string{"Invoice", "Customer", "Product", "Quantity", "Price"}, rows[0])
+			assert.Equal(t, []string{"F1", "Alice", "Apple", "2", "1.5"}, rows[1])
+			assert.Equal(t, []string{"F3", "Carol"}, rows[4])
+			if merge {
+				assert.Equal(t, []string{"", "", "Pear", "1", "2"}, rows[2])
+				merged, _ := file.GetMergeCells(sheet)
+				assert.Len(t, merged, 2)
+			} else {
+				assert.Equal(t, []string{"F1", "Alice", "Pear", "1", "2"}, rows[2])
+			}
+
+			// Read back
+			in, _ := NewReader(file)
+			var read []Invoice
+			assert.NoError(t, in.Unmarshal(&read))
+			assert.Equal(t, invoices, read)
+		})
+	}
+}
+
+// TestMergedCells verifies merging identical values and reading merged cells.
+// It tests:
+// - WithMergeColumns option, on struct fields and map keys
+// - Reading merged cells with and without WithMergedValues
+func TestMergedCells(t *testing.T) {
+
+	type Shop struct {
+		Region string `excel:"Region"`
+		City   string `excel:"City"`
+	}
+
+	shops := []Shop{
+		{Region: "East", City: "Lille"},
+		{Region: "East", City: "Metz"},
+		{Region: "West", City: "Brest"},
+		{Region: "East", City: "Reims"},
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file, WithMergeColumns("Region"))
+	assert.NoError(t, xl.Marshal(&shops))
+
+	merged, err := file.GetMergeCells(xl.Sheet().Name)
+	assert.NoError(t, err)
+	assert.Len(t, merged, 1)
+	assert.Equal(t, "A2", merged[0].GetStartAxis())
+	assert.Equal(t, "A3", merged[0].GetEndAxis())
+
+	// The columns of the maps are merged by key
+	maps := excelize.NewFile()
+	defer func() { _ = maps.Close() }()
+	xlMaps, _ := NewWriter(maps, WithMergeColumns("Region"))
+	assert.NoError(t, xlMaps.Marshal(&[]map[string]string{
+		{"Region": "East", "City": "Lille"},
+		{"Region": "East", "City": "Metz"},
+		{"Region": "West", "City": "Brest"},
+	}))
+	merged, err = maps.GetMergeCells(xlMaps.Sheet().Name)
+	assert.NoError(t, err)
+	assert.Len(t, merged, 1)
+	assert.Equal(t, "B2", merged[0].GetStartAxis())
+	assert.Equal(t, "B3", merged[0].GetEndAxis())
+
+	// The rows of a slice have no titles and can't be merged
+	rows := excelize.NewFile()
+	defer func() { _ = rows.Close() }()
+	xlRows, _ := NewWriter(rows, WithMergeColumns("Region"))
+	assert.ErrorIs(t, xlRows.Marshal(&[][]string{{"Region"}, {"East"}, {"East"}}), ErrNotImplemented)
+
+	// Read back
+	in, _ := NewReader(file, WithMergedValues())
+	var read []Shop
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, shops, read)
+
+	// Only the top-left cell of a merged range holds the value
+	hand := excelize.NewFile()
+	defer func() { _ = hand.Close() }()
+	assert.NoError(t, hand.SetSheetRow("Sheet1", "A1", &[]string{"Region", "City"}))
+	assert.NoError(t, hand.SetSheetRow("Sheet1", "A2", &[]string{"East", "Lille"}))
+	assert.NoError(t, hand.SetSheetRow("Sheet1", "B3", &[]string{"Metz"}))
+	assert.NoError(t, hand.MergeCell("Sheet1", "A2", "A3"))
+
+	in, _ = NewReader(hand)
+	read = nil
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, []Shop{{Region: "East", City: "Lille"}, {Region: "", City: "Metz"}}, read)
+
+	in, _ = NewReader(hand, WithMergedValues())
+	read = nil
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, []Shop{{Region: "East", City: "Lille"}, {Region: "East", City: "Metz"}}, read)
+}
+
+// TestHiddenRowsAndColumns verifies writing hidden columns and skipping hidden cells.
+// It tests:
+// - hidden tag
+// - WithSkipHiddenRows option
+// - WithSkipHiddenColumns option
+func TestHiddenRowsAndColumns(t *testing.T) {
+
+	type Product struct {
+		ID   int    `excel:"ID,hidden,required"`
+		Name string `excel:"Name"`
+	}
+
+	products := []Product{{ID: 1, Name: "Apple"}, {ID: 2, Name: "Pear"}, {ID: 3, Name: "Plum"}}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	assert.NoError(t, xl.Marshal(&products))
+
+	sheet := xl.Sheet().Name
+	visible, _ := file.GetColVisible(sheet, "A")
+	assert.False(t, visible)
+	visible, _ = file.GetColVisible(sheet, "B")
+	assert.True(t, visible)
+
+	// Hide an obsolete row
+	assert.NoError(t, file.SetRowVisible(sheet, 3, false))
+
+	in, _ := NewReader(file)
+	var read []Product
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, products, read)
+
+	// The title of the hidden required column is kept
+	in, _ = NewReader(file, WithSkipHiddenRows(), WithSkipHiddenColumns())
+	read = nil
+	assert.NoError(t, in.Unmarshal(&read))
+	assert.Equal(t, []Product{{Name: "Apple"}, {Name: "Plum"}}, read)
+}
+
+// TestWriteErrors verifies the errors of the cells which can't be written.
+// It tests:
+// - RowError and CellError
+// - Position and type of the cell
+func TestWriteErrors(t *testing.T) {
+	type Product struct {
+		Name   string `excel:"Name"`
+		Action func() `excel:"Action,encoding:json"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	xl.SetAxis("B2")
+	products := []Product{{Name: "Pen", Action: func() {}}}
+	err := xl.Marshal(&products)
+	assert.ErrorIs(t, err, ErrCellWrite)
+
+	var rowErr *RowError
+	assert.True(t, errors.As(err, &rowErr))
+	assert.Equal(t, 3, rowErr.Row)
+
+	var cellErr *CellError
+	assert.True(t, errors.As(err, &cellErr))
+	assert.Equal(t, "C3", cellErr.Cell)
+	assert.Equal(t, 3, cellErr.Col)
+	assert.Equal(t, "func()", cellErr.Type)
+}
+
+// TestLocaleWrite verifies writing localized values.
+// It tests:
+// - WithLocale option and locale tag
+// - bool labels and percent tag
+// - Round trip
+func TestLocaleWrite(t *testing.T) {
+	type Supply struct {
+		Name    string  `excel:"Name"`
+		Price   float64 `excel:"Price,locale"`
+		Weight  float64 `excel:"Weight"`
+		Organic bool    `excel:"Organic,bool:Oui:true|Non:false"`
+		Margin  float64 `excel:"Margin,locale:de-DE,percent"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file, WithLocale("fr-FR"))
+	supplies := []Supply{{Name: "Pomme", Price: 1234567.5, Weight: 2.5, Organic: true, Margin: 0.455}}
+	assert.NoError(t, xl.Marshal(&supplies))
+
+	rows, _ := file.GetRows(xl.Writer.Sheet.Name)
+	assert.Equal(t, []string{"Pomme", "1 234 567,5", "2.5", "Oui", "45,5 %"}, rows[1])
+
+	// Round trip
+	xr, _ := NewReader(file, WithLocale("fr-FR"))
+	var again []Supply
+	assert.NoError(t, xr.Unmarshal(&again))
+	assert.Equal(t, supplies, again)
+}
+
+// TestNumberFormatWrite verifies the number formats of the written cells.
+// It tests:
+// - numfmt and currency tags
+// - Totals with the format of their column
+// - Round trip
+func TestNumberFormatWrite(t *testing.T) {
+	type Invoice struct {
+		Label  string  `excel:"Label"`
+		Rate   float64 `excel:"Rate,numfmt:percent"`
+		Amount float64 `excel:"Amount,currency:EUR,total:sum"`
+		Fee    float64 `excel:"Fee,currency:USD"`
+		Net    float64 `excel:"Net,numfmt:accounting"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	invoices := []Invoice{
+		{Label: "Pens", Rate: 0.125, Amount: 1200.5, Fee: 3, Net: -45},
+		{Label: "Ink", Rate: 0.2, Amount: 30, Fee: 1.5, Net: 12},
+	}
+	assert.NoError(t, xl.Marshal(&invoices))
+
+	sheet := xl.Writer.Sheet.Name
+	rows, _ := file.GetRows(sheet)
+	assert.Equal(t, []string{"Pens", "12.50%", "1,200.50 €", "$3.00", "(45.00)"}, rows[1])
+
+	// The totals have the format of their column
+	style, _ := file.GetCellStyle(sheet, "C4")
+	amount, _ := file.GetCellStyle(sheet, "C2")
+	assert.Equal(t, amount, style)
+
+	// Formatted values are read back
+	xr, _ := NewReader(file, WithMaxRows(2))
+	var again []Invoice
+	assert.NoError(t, xr.Unmarshal(&again))
+	assert.Equal(t, invoices, again)
+}
+
+// TestNullableWrite verifies writing nullable fields.
+// It tests:
+// - sql.Null types and Optional values
+// - Invalid values written as empty cells or with the default
+// - Round trip
+// - Structs of the same shape with an encoding
+func TestNullableWrite(t *testing.T) {
+	type Customer struct {
+		Name   string            `excel:"Name"`
+		Email  sql.NullString    `excel:"Email,default:unknown"`
+		Orders sql.NullInt64     `excel:"Orders"`
+		Since  sql.NullTime      `excel:"Since,format:02/01/2006"`
+		Score  Optional[float64] `excel:"Score,locale:fr-FR"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	xl, _ := NewWriter(file)
+	customers := []Customer{
+		{
+			Name:   "Alice",
+			Email:  sql.NullString{String: "alice@example.com", Valid: true},
+			Orders: sql.NullInt64{Int64: 12, Valid: true},
+			Since:  sql.NullTime{Time: time.Date(2021, 3, 15, 0, 0, 0, 0, time.Local), Valid: true},
+			Score:  Optional[float64]{Value: 4.5, Valid: true},
+		},
+		{Name: "Bob", Orders: sql.NullInt64{Int64: 3}},
+	}
+	assert.NoError(t, xl.Marshal(&customers))
+
+	rows, _ := file.GetRows(xl.Writer.Sheet.Name)
+	assert.Equal(t, []string{"Alice", "alice@example.com", "12", "15/03/2021", "4,5"}, rows[1])
+	assert.Equal(t, []string{"Bob", "unknown"}, rows[2])
+
+	// Round trip
+	xr, _ := NewReader(file)
+	var again []Customer
+	assert.NoError(t, xr.Unmarshal(&again))
+	assert.Equal(t, customers[0], again[0])
+	assert.Equal(t, sql.NullString{String: "unknown", Valid: true}, again[1].Email)
+	assert.False(t, again[1].Orders.Valid)
+	assert.False(t, again[1].Score.Valid)
+
+	// Structs of the same shape are not nullable
+	type Status struct {
+		Code  string `json:"code"`
+		Valid bool   `json:"valid"`
+	}
+	type Order struct {
+		Name   string `excel:"Name"`
+		Status Status `excel:"Status,encoding:json"`
+	}
+	orders := []Order{{Name: "Pen", Status: Status{Code: "new"}}}
+	out := excelize.NewFile()
+	defer func() { _ = out.Close() }()
+	xo, _ := NewWriter(out)
+	assert.NoError(t, xo.Marshal(&orders))
+	rows, _ = out.GetRows(xo.Writer.Sheet.Name)
+	assert.Equal(t, []string{"Pen", `{"code":"new","valid":false}`}, rows[1])
+
+	xr, _ = NewReader(out)
+	var read []Order
+	assert.NoError(t, xr.Unmarshal(&read))
+	assert.Equal(t, orders, read)
+}
+
+// TestExactWrite verifies writing numbers without losing precision.
+// It tests:
+// - Durations written as fractions of day
+// - Big numbers, decimals and long integers written as text when needed
+// - Round trip
+func TestExactWrite(t *testing.T) {
+	type Payment struct {
+		Duration time.Duration `excel:"Duration"`
+		ID       *big.Int      `excel:"ID"`
+		Amount   *big.Float    `excel:"Amount,precision:2"`
+		Price    string        `excel:"Price,precision:2"`
+		Ref      int64         `excel:"Ref"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	id, _ := new(big.Int).SetString("123456789012345678901234", 10)
+	amount, _ := new(big.Float).SetString("1234.5")
+	payments := []Payment{
+		{Duration: 90 * time.Minute, ID: id, Amount: amount, Price: "12345678901234567.891", Ref: 1234567890123456789},
+		{Duration: 36 * time.Hour, ID: big.NewInt(42), Price: "0.5", Ref: 12},
+	}
+
+	xl, _ := NewWriter(file)
+	assert.NoError(t, xl.Marshal(&payments))
+
+	sheet := xl.Writer.Sheet.Name
+	rows, _ := file.GetRows(sheet)
+	assert.Equal(t, []string{"1:30:00", "123456789012345678901234", "1234.50", "12345678901234567.89", "1234567890123456789"}, rows[1])
+	assert.Equal(t, []string{"36:00:00", "42", "", "0.50", "12"}, rows[2])
+
+	// Durations are stored as fractions of day, long numbers as text
+	raw, _ := file.GetCellValue(sheet, "A2", excelize.Options{RawCellValue: true})
+	assert.Equal(t, "0.0625", raw)
+	cellType, _ := file.GetCellType(sheet, "B2")
+	assert.Equal(t, excelize.CellTypeSharedString, cellType)
+	cellType, _ = file.GetCellType(sheet, "B3")
+	assert.NotEqual(t, excelize.CellTypeSharedString, cellType)
+
+	// Round trip
+	xr, _ := NewReader(file)
+	var again []Payment
+	assert.NoError(t, xr.Unmarshal(&again))
+	assert.Equal(t, payments[0].Duration, again[0].Duration)
+	assert.Equal(t, payments[1].Duration, again[1].Duration)
+	assert.Equal(t, 0, id.Cmp(again[0].ID))
+	assert.Equal(t, "1234.50", again[0].Amount.Text('f', 2))
+	assert.Equal(t, "12345678901234567.89", again[0].Price)
+	assert.Equal(t, payments[0].Ref, again[0].Ref)
+}
+
+// phone is written in one cell as "+33 612345678"
+type phone struct {
+	Country string
+	Number  string
+}
+
+func (p phone) Marshall() (interface{}, error) {
+	return "+" + p.Country + " " + p.Number, nil
+}
+
+func (p *phone) Unmarshall(s string) error {
+	country, number, ok := strings.Cut(strings.TrimPrefix(s, "+"), " ")
+	if !ok {
+		return fmt.Errorf("'%s' is not a phone number", s)
+	}
+	p.Country, p.Number = country, number
+	return nil
+}
+
+// TestMapAndRepeatWrite verifies writing maps and repeated columns.
+// It tests:
+// - Maps in one cell with the split and kvsep tags
+// - Slices spread across repeated columns
+// - Round trip
+// - Map keys and values holding a separator
+// - Struct elements encoded or rejected
+func TestMapAndRepeatWrite(t *testing.T) {
+	type Contact struct {
+		Name   string         `excel:"Name"`
+		Attrs  map[string]int `excel:"Attrs,split:|,kvsep::"`
+		Phones []phone        `excel:"repeat:Phone {n}"`
+		Email  string         `excel:"Email"`
+	}
+
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	contacts := []Contact{
+		{Name: "Alice", Attrs: map[string]int{"b": 2, "a": 1}, Phones: []phone{{"33", "612345678"}, {"41", "791234567"}}, Email: "alice@example.com"},
+		{Name: "Bob", Phones: []phone{{"1", "5551234"}}},
+	}
+
+	xl, _ := NewWriter(file)
+	assert.NoError(t, xl.Marshal(&contacts))
+	assert.Equal(t, 5, xl.Writer.Result.Columns)
+
+	rows, _ := file.GetRows(xl.Writer.Sheet.Name)
+	assert.Equal(t, []string{"Name", "Attrs", "Email", "Phone 1", "Phone 2"}, rows[0])
+	assert.Equal(t, []string{"Alice", "a:1|b:2", "alice@example.com", "+33 612345678", "+41 791234567"}, rows[1])
+	assert.Equal(t, []string{"Bob", "", "", "+1 5551234"}, rows[2])
+
+	// Round trip
+	xr, _ := NewReader(file)
+	var again []Contact
+	assert.NoError(t, xr.Unmarshal(&again))
+	assert.Equal(t, contacts[0], again[0])
+	assert.Equal(t, contacts[1].Phones, again[1].Phones)
+	assert.Empty(t, again[1].Attrs)
+
+	// Keys and values holding a separator can't be read back
+	for _, attrs := range []map[string]int{{"a|b": 1}, {"a:b": 1}} {
+		separated := excelize.NewFile()
+		xs, _ := NewWriter(separated)
+		err := xs.Marshal(&[]Contact{{Name: "Carol", Attrs: attrs}})
+		assert.ErrorIs(t, err, ErrCellWrite)
+		assert.ErrorContains(t, err, "separator")
+		_ = separated.Close()
+	}
+	type Labels struct {
+		Labels map[string]string `excel:"Labels"`
+	}
+	separated := excelize.NewFile()
+	defer func() { _ = separated.Close() }()
+	xs, _ := NewWriter(separated)
+	assert.ErrorIs(t, xs.Marshal(&[]Labels{{Labels: map[string]string{"a": "x;y"}}}), ErrCellWrite)
+	assert.NoError(t, xs.Marshal(&[]Labels{{Labels: map[string]string{"a": "x=y"}}}))
+	xr, _ = NewReader(separated)
+	var labels []Labels
+	assert.NoError(t, xr.Unmarshal(&labels))
+	assert.Equal(t, map[string]string{"a": "x=y"}, labels[0].Labels)
+
+	// Struct elements are encoded or rejected
+	type Line struct {
+		Country string `json:"country"`
+		Number  string `json:"number"`
+	}
+	type Encoded struct {
+		Name   string `excel:"Name"`
+		Phones []Line `excel:"repeat:Phone {n},encoding:json"`
+	}
+	encoded := []Encoded{{Name: "Alice", Phones: []Line{{"33", "612345678"}}}}
+	out := excelize.NewFile()
+	defer func() { _ = out.Close() }()
+	xo, _ := NewWriter(out)
+	assert.NoError(t, xo.Marshal(&encoded))
+	rows, _ = out.GetRows(xo.Writer.Sheet.Name)
+	assert.Equal(t, []string{"Alice", `{"country":"33","number":"612345678"}`}, rows[1])
+	xr, _ = NewReader(out)
+	var decoded []Encoded
+	assert.NoError(t, xr.Unmarshal(&decoded))
+	assert.Equal(t, encoded, decoded)
+
+	type Plain struct {
+		Name   string `excel:"Name"`
+		Phones []Line `excel:"repeat:Phone {n}"`
+	}
+	plain := []Plain{{Name: "Alice", Phones: []Line{{"33", "612345678"}}}}
+	assert.ErrorContains(t, xo.Marshal(&plain), "can't be held in a cell")
+	assert.ErrorContains(t, xr.Unmarshal(&plain), "can't be held in a cell")
+}
@@ -0,0 +1,387 @@
+package excel
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Functions of the total tag
+const (
+	TotalSum   = "sum"
+	TotalAvg   = "avg"
+	TotalCount = "count"
+	TotalMin   = "min"
+	TotalMax   = "max"
+)
+
+// totalFunction describes how a total is computed
+type totalFunction struct {
+	// formula is the function used in a totals row without subtotals
+	formula string
+	// subtotal is the function number used with SUBTOTAL
+	subtotal int
+	// table is the function of a table totals row
+	table string
+}
+
+// totalFunctions are the functions of the total tag
+var totalFunctions = map[string]totalFunction{
+	TotalSum:   {formula: "SUM", subtotal: 9, table: "sum"},
+	TotalAvg:   {formula: "AVERAGE", subtotal: 1, table: "average"},
+	TotalCount: {formula: "COUNT", subtotal: 2, table: "count"},
+	TotalMin:   {formula: "MIN", subtotal: 5, table: "min"},
+	TotalMax:   {formula: "MAX", subtotal: 4, table: "max"},
+}
+
+// totalLabel is the label of the totals row
+const totalLabel = "Total"
+
+// WithSubtotals adds a subtotal row after each group of rows having the same value
+// in the field named key. The rows must be sorted by key.
+// Subtotals are computed for the fields having a total tag.
+func WithSubtotals(key string) Option {
+	return func(o *options) {
+		o.subtotalKey = key
+	}
+}
+
+// totalFunction returns the function of the total tag of a field
+func (f *Field) totalFunction() (totalFunction, bool, error) {
+	name := f.GetWriteTotal()
+	if len(name) == 0 {
+		return totalFunction{}, false, nil
+	}
+	fn, ok := totalFunctions[strings.ToLower(name)]
+	if !ok {
+		return totalFunction{}, false, fmt.Errorf("%w: '%s'", ErrTotalNotValid, name)
+	}
+	return fn, true, nil
+}
+
+// hasTotals returns true if a field has a total tag
+func (w *StructWriter) hasTotals() bool {
+	for _, f := range w.Struct.Fields {
+		if f != nil && !f.GetWriteIgnore() && len(f.GetWriteTotal()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// labelField returns the field holding the label of the totals row:
+// the first written column without total
+func (w *StructWriter) labelField() *Field {
+	var label *Field
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() || len(f.GetWriteTotal()) > 0 {
+			continue
+		}
+		if label == nil || f.WriteTags.index < label.WriteTags.index {
+			label = f
+		}
+	}
+	return label
+}
+
+// writeSubtotal writes a subtotal row for the data rows between firstRow and lastRow
+func (w *StructWriter) writeSubtotal(row, firstRow, lastRow int, key *Field, label string) error {
+	col := w.Writer.Axis.Col
+	file, sheet := w.Writer.file, w.Writer.Sheet.Name
+
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+		cell, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, row)
+		if err != nil {
+			return err
+		}
+
+		fn, ok, err := f.totalFunction()
+		if err != nil {
+			return fmt.Errorf("excel: invalid total of field '%s': %w", f.Name, err)
+		}
+		switch {
+		case ok:
+			rng, err := columnRef(col+f.WriteTags.index, firstRow, lastRow)
+			if err != nil {
+				return err
+			}
+			formula := fmt.Sprintf("SUBTOTAL(%d,%s)", fn.subtotal, rng)
+			if err := file.SetCellFormula(sheet, cell, formula); err != nil {
+				return err
+			}
+			if fn.formula != totalFunctions[TotalCount].formula {
+				if err := w.writeNumFmt(f, cell); err != nil {
+					return err
+				}
+			}
+			w.Writer.trackCell(cell, "")
+		case f == key:
+			text := label + " " + totalLabel
+			if err := file.SetCellValue(sheet, cell, text); err != nil {
+				return err
+			}
+			w.Writer.trackCell(cell, text)
+		}
+	}
+	return nil
+}
+
+// writeTotals writes the totals row under the data rows written between firstRow and lastRow.
+// When the data has been written in a table, the row is the totals row of the table
+// and the totals reference its columns (ie: SUBTOTAL(109,Sales[Quantity])).
+func (w *StructWriter) writeTotals(firstRow, lastRow int) error {
+	if !w.hasTotals() || lastRow < firstRow {
+		return nil
+	}
+
+	table, err := w.targetTable()
+	if err != nil {
+		return err
+	}
+
+	col := w.Writer.Axis.Col
+	row := lastRow + 1
+	file, sheet := w.Writer.file, w.Writer.Sheet.Name
+	subtotals := len(w.Writer.opts.subtotalKey) > 0
+
+	// Functions and label of the table totals row, by column
+	totals := make(map[string]tableTotal)
+
+	// Label of the totals row
+	if label := w.labelField(); label != nil {
+		totals[label.GetWriteColumnName()] = tableTotal{label: totalLabel}
+		cell, err := excelize.CoordinatesToCellName(col+label.WriteTags.index, row)
+		if err != nil {
+			return err
+		}
+		if err := file.SetCellValue(sheet, cell, totalLabel); err != nil {
+			return err
+		}
+	}
+
+	// Total of each column
+	for _, f := range w.Struct.Fields {
+		if f == nil || f.GetWriteIgnore() {
+			continue
+		}
+		fn, ok, err := f.totalFunction()
+		if err != nil {
+			return fmt.Errorf("excel: invalid total of field '%s': %w", f.Name, err)
+		}
+		if !ok {
+			continue
+		}
+
+		var formula string
+		switch {
+		case table != nil:
+			formula = fmt.Sprintf("SUBTOTAL(%d,%s[%s])", fn.subtotal+100, table.Name, f.GetWriteColumnName())
+			totals[f.GetWriteColumnName()] = tableTotal{function: fn.table}
+		case subtotals:
+			rng, err := columnRef(col+f.WriteTags.index, firstRow, lastRow)
+			if err != nil {
+				return err
+			}
+			formula = fmt.Sprintf("SUBTOTAL(%d,%s)", fn.subtotal, rng)
+		default:
+			rng, err := columnRef(col+f.WriteTags.index, firstRow, lastRow)
+			if err != nil {
+				return err
+			}
+			formula = fmt.Sprintf("%s(%s)", fn.formula, rng)
+		}
+
+		cell, err := excelize.CoordinatesToCellName(col+f.WriteTags.index, row)
+		if err != nil {
+			return err
+		}
+		if err := file.SetCellFormula(sheet, cell, formula); err != nil {
+			return err
+		}
+		if fn.formula != totalFunctions[TotalCount].formula {
+			if err := w.writeNumFmt(f, cell); err != nil {
+				return err
+			}
+		}
+	}
+
+	if table != nil {
+		return w.setTableTotals(table, row, totals)
+	}
+	return nil
+}
+
+// targetTable returns the table of the sheet starting at the axis of the writer
+func (w *StructWriter) targetTable() (*excelize.Table, error) {
+	tables, err := w.Writer.file.GetTables(w.Writer.Sheet.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		rng, err := ToRange(t.Range)
+		if err != nil {
+			continue
+		}
+		if rng.StartColumn == w.Writer.Axis.Col && rng.StartRow == w.Writer.Axis.Row {
+			t := t
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
+
+// tableTotal is the function or the label of a column in the totals row of a table
+type tableTotal struct {
+	function string
+	label    string
+}
+
+// setTableTotals resizes the table over the header, the data rows and the totals row,
+// then shows its totals row with the function or the label of each column.
+func (w *StructWriter) setTableTotals(table *excelize.Table, totalsRow int, totals map[string]tableTotal) error {
+	rng, err := ToRange(table.Range)
+	if err != nil {
+		return err
+	}
+	rng.EndRow = totalsRow
+	if err := rng.UpdateNames(); err != nil {
+		return err
+	}
+	file := w.Writer.file
+	if err := file.ResizeTable(table.Name, rng.ToRef()); err != nil {
+		return fmt.Errorf("excel: failed to resize table '%s': %w", table.Name, err)
+	}
+
+	// Excelize has no API for the totals row: it is set in the XML part of the table
+	part, content, err := tablePart(file, table.Name)
+	if err != nil {
+		return err
+	}
+	rng.EndRow--
+	if err := rng.UpdateNames(); err != nil {
+		return err
+	}
+	content, err = showTotalsRow(content, rng.ToRef(), totals)
+	if err != nil {
+		return fmt.Errorf("excel: failed to set the totals row of table '%s': %w", table.Name, err)
+	}
+	file.Pkg.Store(part, content)
+	return nil
+}
+
+// tablePart returns the path and the XML content of the part of a table
+func tablePart(file *excelize.File, name string) (string, []byte, error) {
+	var part string
+	var content []byte
+	file.Pkg.Range(func(key, value any) bool {
+		path, ok := key.(string)
+		if !ok || !strings.HasPrefix(path, "xl/tables/table") || !strings.HasSuffix(path, ".xml") {
+			return true
+		}
+		data, ok := value.([]byte)
+		if !ok {
+			return true
+		}
+		var table struct {
+			Name string `xml:"name,attr"`
+		}
+		if err := xml.Unmarshal(data, &table); err == nil && table.Name == name {
+			part, content = path, data
+			return false
+		}
+		return true
+	})
+	if content == nil {
+		return "", nil, fmt.Errorf("excel: table '%s' not found", name)
+	}
+	return part, content, nil
+}
+
+// showTotalsRow returns the XML content of a table with a totals row.
+// The autofilter covers the header and the data rows given by ref.
+func showTotalsRow(content []byte, ref string, totals map[string]tableTotal) ([]byte, error) {
+	var buf bytes.Buffer
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	encoder := xml.NewEncoder(&buf)
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			switch start.Name.Local {
+			case "table":
+				start.Attr = setAttr(start.Attr, "totalsRowShown", "")
+				start.Attr = setAttr(start.Attr, "totalsRowCount", "1")
+			case "autoFilter":
+				start.Attr = setAttr(start.Attr, "ref", ref)
+			case "tableColumn":
+				if total, ok := totals[attrValue(start.Attr, "name")]; ok {
+					start.Attr = setAttr(start.Attr, "totalsRowFunction", total.function)
+					start.Attr = setAttr(start.Attr, "totalsRowLabel", total.label)
+				}
+			}
+			token = start
+		}
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// attrValue returns the value of an attribute
+func attrValue(attrs []xml.Attr, name string) string {
+	for _, attr := range attrs {
+		if attr.Name.Space == "" && attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// setAttr sets the value of an attribute. The attribute is removed if the value is empty.
+func setAttr(attrs []xml.Attr, name, value string) []xml.Attr {
+	attrs = slices.DeleteFunc(attrs, func(attr xml.Attr) bool {
+		return attr.Name.Space == "" && attr.Name.Local == name
+	})
+	if len(value) > 0 {
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	}
+	return attrs
+}
+
+// groupKey returns the value of the key of a subtotal group
+func groupKey(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// columnRef returns the reference of the rows of a column (ie: B2:B10)
+func columnRef(col, firstRow, lastRow int) (string, error) {
+	rng := Range{StartColumn: col, StartRow: firstRow, EndColumn: col, EndRow: lastRow}
+	if err := rng.UpdateNames(); err != nil {
+		return "", err
+	}
+	return rng.ToRef(), nil
+}